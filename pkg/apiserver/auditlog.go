@@ -0,0 +1,202 @@
+package apiserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	authenticationV1 "k8s.io/api/authentication/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KubeAPIServerAuditLog is the audit log path of the kube-apiserver relative to the node log directory.
+	KubeAPIServerAuditLog = "kube-apiserver/audit.log"
+	// OpenShiftAPIServerAuditLog is the audit log path of the openshift-apiserver relative to the node log directory.
+	OpenShiftAPIServerAuditLog = "openshift-apiserver/audit.log"
+	// OAuthAPIServerAuditLog is the audit log path of the oauth-apiserver relative to the node log directory.
+	OAuthAPIServerAuditLog = "oauth-apiserver/audit.log"
+
+	controlPlaneNodeLabel = "node-role.kubernetes.io/master"
+	// maxAuditLineSize is the biggest audit event line accepted by the parser. Audit events including request and
+	// response bodies easily exceed the default bufio.Scanner limit.
+	maxAuditLineSize = 10 * 1024 * 1024
+)
+
+// AuditObjectReference contains the reference to the object targeted by an audited request.
+type AuditObjectReference struct {
+	Resource    string `json:"resource,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name,omitempty"`
+	APIGroup    string `json:"apiGroup,omitempty"`
+	APIVersion  string `json:"apiVersion,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+}
+
+// AuditEvent represents a single API server audit log entry.
+type AuditEvent struct {
+	Level                    string                    `json:"level"`
+	AuditID                  string                    `json:"auditID"`
+	Stage                    string                    `json:"stage"`
+	RequestURI               string                    `json:"requestURI"`
+	Verb                     string                    `json:"verb"`
+	User                     authenticationV1.UserInfo `json:"user"`
+	SourceIPs                []string                  `json:"sourceIPs,omitempty"`
+	UserAgent                string                    `json:"userAgent,omitempty"`
+	ObjectRef                *AuditObjectReference     `json:"objectRef,omitempty"`
+	ResponseStatus           *metaV1.Status            `json:"responseStatus,omitempty"`
+	RequestReceivedTimestamp metaV1.MicroTime          `json:"requestReceivedTimestamp"`
+	StageTimestamp           metaV1.MicroTime          `json:"stageTimestamp"`
+	Annotations              map[string]string         `json:"annotations,omitempty"`
+	// NodeName is the control-plane node the event was collected from. It is not part of the audit log itself.
+	NodeName string `json:"-"`
+}
+
+// AuditLogFilter defines which audit events are returned. Empty fields are not used for filtering.
+type AuditLogFilter struct {
+	User      string
+	Verb      string
+	Resource  string
+	Namespace string
+	Since     time.Time
+	Until     time.Time
+}
+
+// GetAuditEvents fetches the given audit log from all control-plane nodes and returns the events
+// matching the filter.
+func GetAuditEvents(apiClient *clients.Settings, logPath string, filter AuditLogFilter) ([]AuditEvent, error) {
+	glog.V(100).Infof("Collecting audit events from %s with filter %+v", logPath, filter)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to collect audit events, 'apiClient' parameter is nil")
+	}
+
+	if logPath == "" {
+		glog.V(100).Infof("The audit log path is empty")
+
+		return nil, fmt.Errorf("failed to collect audit events, 'logPath' parameter is empty")
+	}
+
+	controlPlaneNodes := nodes.NewBuilder(apiClient, map[string]string{controlPlaneNodeLabel: ""})
+
+	if err := controlPlaneNodes.Discover(); err != nil {
+		return nil, err
+	}
+
+	var auditEvents []AuditEvent
+
+	for _, node := range controlPlaneNodes.Objects {
+		nodeEvents, err := GetNodeAuditEvents(apiClient, node.Object.Name, logPath, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		auditEvents = append(auditEvents, nodeEvents...)
+	}
+
+	return auditEvents, nil
+}
+
+// GetNodeAuditEvents fetches the given audit log from a single node and returns the events matching the filter.
+func GetNodeAuditEvents(
+	apiClient *clients.Settings, nodeName, logPath string, filter AuditLogFilter) ([]AuditEvent, error) {
+	glog.V(100).Infof("Collecting audit events from %s on node %s", logPath, nodeName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to collect audit events, 'apiClient' parameter is nil")
+	}
+
+	if nodeName == "" {
+		glog.V(100).Infof("The node name is empty")
+
+		return nil, fmt.Errorf("failed to collect audit events, 'nodeName' parameter is empty")
+	}
+
+	rawLog, err := apiClient.CoreV1Interface.RESTClient().
+		Get().
+		AbsPath("/api/v1/nodes", nodeName, "proxy", "logs", logPath).
+		DoRaw(context.TODO())
+
+	if err != nil {
+		glog.V(100).Infof("Failed to read %s from node %s due to %s", logPath, nodeName, err.Error())
+
+		return nil, fmt.Errorf("failed to read audit log %s from node %s: %w", logPath, nodeName, err)
+	}
+
+	return parseAuditLog(rawLog, nodeName, filter)
+}
+
+// Matches returns true when the audit event satisfies the filter.
+func (filter AuditLogFilter) Matches(event AuditEvent) bool {
+	if filter.User != "" && event.User.Username != filter.User {
+		return false
+	}
+
+	if filter.Verb != "" && event.Verb != filter.Verb {
+		return false
+	}
+
+	if filter.Resource != "" && (event.ObjectRef == nil || event.ObjectRef.Resource != filter.Resource) {
+		return false
+	}
+
+	if filter.Namespace != "" && (event.ObjectRef == nil || event.ObjectRef.Namespace != filter.Namespace) {
+		return false
+	}
+
+	if !filter.Since.IsZero() && event.RequestReceivedTimestamp.Time.Before(filter.Since) {
+		return false
+	}
+
+	if !filter.Until.IsZero() && event.RequestReceivedTimestamp.Time.After(filter.Until) {
+		return false
+	}
+
+	return true
+}
+
+// parseAuditLog decodes the json lines audit log and keeps the events matching the filter.
+func parseAuditLog(rawLog []byte, nodeName string, filter AuditLogFilter) ([]AuditEvent, error) {
+	var auditEvents []AuditEvent
+
+	scanner := bufio.NewScanner(bytes.NewReader(rawLog))
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxAuditLineSize)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var event AuditEvent
+
+		if err := json.Unmarshal(line, &event); err != nil {
+			glog.V(100).Infof("Skipping malformed audit log line from node %s: %s", nodeName, err.Error())
+
+			continue
+		}
+
+		if !filter.Matches(event) {
+			continue
+		}
+
+		event.NodeName = nodeName
+		auditEvents = append(auditEvents, event)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse audit log from node %s: %w", nodeName, err)
+	}
+
+	return auditEvents, nil
+}
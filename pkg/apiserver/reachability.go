@@ -0,0 +1,83 @@
+package apiserver
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// handshakeTimeout bounds a single connection attempt including the TLS handshake.
+const handshakeTimeout = 10 * time.Second
+
+// WaitForKubeAPIReachable waits for timeout duration or until a TLS handshake with the kube-apiserver at the given
+// endpoint succeeds, e.g. the NodePort or load balancer of a hosted control plane. The endpoint is either host:port
+// or an https URL as found in a kubeconfig. The server certificate is not verified, since the probe only checks
+// that the endpoint is reachable before a kubeconfig is used against it.
+func WaitForKubeAPIReachable(endpoint string, timeout time.Duration, options ...await.WaitOption) error {
+	glog.V(100).Infof("Waiting for kube-apiserver endpoint %s to be reachable", endpoint)
+
+	address, err := endpointAddress(endpoint)
+	if err != nil {
+		return err
+	}
+
+	var lastObserved string
+
+	err = await.Poll(timeout, func() (bool, error) {
+		dialer := &net.Dialer{Timeout: handshakeTimeout}
+
+		// The server certificate is deliberately not verified, only the reachability of the endpoint is probed.
+		conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{
+			InsecureSkipVerify: true,
+		})
+		if err != nil {
+			glog.V(100).Infof("TLS handshake with %s failed: %s", address, err.Error())
+
+			lastObserved = fmt.Sprintf("handshake error: %s", err.Error())
+
+			return false, nil
+		}
+
+		_ = conn.Close()
+
+		return true, nil
+	}, options...)
+
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          schema.GroupVersionKind{Kind: "Endpoint"},
+		Name:         address,
+		Wanted:       "successful TLS handshake",
+		LastObserved: lastObserved,
+	})
+}
+
+// endpointAddress returns the host:port to dial for the given endpoint. An https URL without a port defaults to
+// port 443.
+func endpointAddress(endpoint string) (string, error) {
+	if endpoint == "" {
+		glog.V(100).Infof("The kube-apiserver endpoint is empty")
+
+		return "", fmt.Errorf("kube-apiserver endpoint cannot be empty")
+	}
+
+	if _, _, err := net.SplitHostPort(endpoint); err == nil {
+		return endpoint, nil
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Host == "" {
+		return "", fmt.Errorf("kube-apiserver endpoint %q is neither host:port nor a URL", endpoint)
+	}
+
+	if endpointURL.Port() != "" {
+		return endpointURL.Host, nil
+	}
+
+	return net.JoinHostPort(endpointURL.Hostname(), "443"), nil
+}
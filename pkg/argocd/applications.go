@@ -42,13 +42,13 @@ func PullApplication(apiClient *clients.Settings, name, nsname string) (*Applica
 	if name == "" {
 		glog.V(100).Infof("The name of the Application is empty")
 
-		builder.errorMsg = "Application 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Application 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the Application is empty")
 
-		builder.errorMsg = "Application 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Application 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -185,13 +185,14 @@ func (builder *ApplicationBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -212,19 +213,19 @@ func (builder *ApplicationBuilder) WithGitDetails(gitRepo, gitBranch, gitPath st
 	if gitRepo == "" {
 		glog.V(100).Infof("The 'gitRepo' of the argocd application is empty")
 
-		builder.errorMsg = "'gitRepo' parameter is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'gitRepo' parameter is empty")
 	}
 
 	if gitBranch == "" {
 		glog.V(100).Infof("The 'gitBranch' of the argocd application is empty")
 
-		builder.errorMsg = "'gitBranch' parameter is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'gitBranch' parameter is empty")
 	}
 
 	if gitPath == "" {
 		glog.V(100).Infof("The 'gitPath' of the argocd application is empty")
 
-		builder.errorMsg = "'gitPath' parameter is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'gitPath' parameter is empty")
 	}
 
 	glog.V(100).Infof(
@@ -42,13 +42,13 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	if name == "" {
 		glog.V(100).Infof("The name of the argocd is empty")
 
-		builder.errorMsg = "argocd 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "argocd 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the argocd is empty")
 
-		builder.errorMsg = "argocd 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "argocd 'nsname' cannot be empty")
 	}
 
 	return &builder
@@ -71,13 +71,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if name == "" {
 		glog.V(100).Infof("The name of the argocd is empty")
 
-		builder.errorMsg = "argocd 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "argocd 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the argocd is empty")
 
-		builder.errorMsg = "argocd 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "argocd 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -217,13 +217,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
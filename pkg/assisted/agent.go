@@ -6,13 +6,13 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	"github.com/openshift/assisted-service/models"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -68,13 +68,13 @@ func PullAgent(apiClient *clients.Settings, name, nsname string) (*agentBuilder,
 	if name == "" {
 		glog.V(100).Infof("The name of the agent is empty")
 
-		builder.errorMsg = "agent 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agent 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the agent is empty")
 
-		builder.errorMsg = "agent 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agent 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -99,7 +99,7 @@ func (builder *agentBuilder) WithHostName(hostname string) *agentBuilder {
 		glog.V(100).Infof("agent %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = nonExistentMsg
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, nonExistentMsg)
 	}
 
 	if builder.errorMsg != "" {
@@ -124,7 +124,7 @@ func (builder *agentBuilder) WithRole(role string) *agentBuilder {
 		glog.V(100).Infof("agent %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = nonExistentMsg
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, nonExistentMsg)
 	}
 
 	if builder.errorMsg != "" {
@@ -179,7 +179,8 @@ func (builder *agentBuilder) WithApproval(approved bool) *agentBuilder {
 }
 
 // WaitForState waits the specified timeout for the agent to report the specified state.
-func (builder *agentBuilder) WaitForState(state string, timeout time.Duration) (*agentBuilder, error) {
+func (builder *agentBuilder) WaitForState(
+	state string, timeout time.Duration, options ...await.WaitOption) (*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -187,27 +188,36 @@ func (builder *agentBuilder) WaitForState(state string, timeout time.Duration) (
 	glog.V(100).Infof("Waiting for agent %s in namespace %s to report state %s",
 		builder.Definition.Name, builder.Definition.Namespace, state)
 
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if agent is in desired state.
-	var err error
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	var (
+		err          error
+		lastObserved string
+	)
+
+	err = await.Poll(timeout, func() (bool, error) {
 		builder.Object, err = builder.Get()
 
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("state %q", builder.Object.Status.DebugInfo.State)
+
 		return builder.Object.Status.DebugInfo.State == state, nil
-	})
+	}, options...)
 
 	if err == nil {
 		return builder, nil
 	}
 
-	return nil, err
+	return nil, builder.withTimeoutDetails(err, fmt.Sprintf("state %q", state), lastObserved)
 }
 
 // WaitForStateInfo waits the specified timeout for the agent to report the specified stateInfo.
-func (builder *agentBuilder) WaitForStateInfo(stateInfo string, timeout time.Duration) (*agentBuilder, error) {
+func (builder *agentBuilder) WaitForStateInfo(
+	stateInfo string, timeout time.Duration, options ...await.WaitOption) (*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -215,23 +225,31 @@ func (builder *agentBuilder) WaitForStateInfo(stateInfo string, timeout time.Dur
 	glog.V(100).Infof("Waiting for agent %s in namespace %s to report stateInfo %s",
 		builder.Definition.Name, builder.Definition.Namespace, stateInfo)
 
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if agent is in desired state.
-	var err error
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	var (
+		err          error
+		lastObserved string
+	)
+
+	err = await.Poll(timeout, func() (bool, error) {
 		builder.Object, err = builder.Get()
 
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("stateInfo %q", builder.Object.Status.DebugInfo.StateInfo)
+
 		return builder.Object.Status.DebugInfo.StateInfo == stateInfo, nil
-	})
+	}, options...)
 
 	if err == nil {
 		return builder, nil
 	}
 
-	return nil, err
+	return nil, builder.withTimeoutDetails(err, fmt.Sprintf("stateInfo %q", stateInfo), lastObserved)
 }
 
 // WithOptions creates agent with generic mutation options.
@@ -244,15 +262,19 @@ func (builder *agentBuilder) WithOptions(options ...AgentAdditionalOptions) *age
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -296,7 +318,7 @@ func (builder *agentBuilder) Update() (*agentBuilder, error) {
 		glog.V(100).Infof("agent %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = nonExistentMsg
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, nonExistentMsg)
 	}
 
 	if builder.errorMsg != "" {
@@ -350,6 +372,17 @@ func (builder *agentBuilder) Delete() (*agentBuilder, error) {
 	return builder, nil
 }
 
+// withTimeoutDetails converts a wait timeout into an await.WaitTimeoutError referencing the agent.
+func (builder *agentBuilder) withTimeoutDetails(err error, wanted, lastObserved string) error {
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          agentInstallV1Beta1.GroupVersion.WithKind("Agent"),
+		Name:         builder.Definition.Name,
+		Namespace:    builder.Definition.Namespace,
+		Wanted:       wanted,
+		LastObserved: lastObserved,
+	})
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *agentBuilder) validate() (bool, error) {
@@ -364,13 +397,14 @@ func (builder *agentBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
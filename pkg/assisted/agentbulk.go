@@ -0,0 +1,110 @@
+package assisted
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApproveAllAgentsMatching approves every agent in the cluster matching the given labels, e.g. the infraenv label
+// or the bmh label set by the assisted-service, and returns the agents that were approved by this call.
+// An empty selector matches all agents.
+func ApproveAllAgentsMatching(apiClient *clients.Settings, selector map[string]string) ([]*agentBuilder, error) {
+	glog.V(100).Infof("Approving all agents matching %v", selector)
+
+	if apiClient == nil {
+		glog.V(100).Infof("agents 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to approve agents, 'apiClient' parameter is empty")
+	}
+
+	var agents agentInstallV1Beta1.AgentList
+
+	err := apiClient.List(context.TODO(), &agents, goclient.MatchingLabels(selector))
+	if err != nil {
+		glog.V(100).Infof("Failed to list agents matching %v due to %s", selector, err.Error())
+
+		return nil, err
+	}
+
+	var (
+		approvedAgents []*agentBuilder
+		approveErrors  []error
+	)
+
+	for _, agent := range agents.Items {
+		if agent.Spec.Approved {
+			continue
+		}
+
+		copiedAgent := agent
+		agentBuilder, err := newAgentBuilder(apiClient, &copiedAgent).WithApproval(true).Update()
+
+		if err != nil {
+			approveErrors = append(approveErrors,
+				fmt.Errorf("failed to approve agent %s in namespace %s: %w", agent.Name, agent.Namespace, err))
+
+			continue
+		}
+
+		approvedAgents = append(approvedAgents, agentBuilder)
+	}
+
+	return approvedAgents, utilerrors.NewAggregate(approveErrors)
+}
+
+// WaitForNAgentsBound waits the specified timeout for at least count agents of the infraenv to be bound to
+// a cluster and returns the bound agents. On timeout the returned *await.WaitTimeoutError reports the numbers of
+// bound and unbound agents last observed.
+func (builder *InfraEnvBuilder) WaitForNAgentsBound(
+	count int, timeout time.Duration, options ...await.WaitOption) ([]*agentBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Waiting for %d agents of infraenv %s in namespace %s to be bound",
+		count, builder.Definition.Name, builder.Definition.Namespace)
+
+	if count <= 0 {
+		glog.V(100).Infof("The agent count is not positive")
+
+		return nil, fmt.Errorf("agent count must be greater than 0")
+	}
+
+	var (
+		boundAgents  []*agentBuilder
+		lastObserved string
+	)
+
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
+	// Polls every retryInterval to determine if enough agents are bound.
+	err := await.Poll(timeout, func() (bool, error) {
+		agents, err := builder.GetAllAgents()
+		if err != nil {
+			return false, nil
+		}
+
+		boundAgents = nil
+
+		for _, agent := range agents {
+			if conditionsv1.IsStatusConditionTrue(agent.Object.Status.Conditions, agentInstallV1Beta1.BoundCondition) {
+				boundAgents = append(boundAgents, agent)
+			}
+		}
+
+		lastObserved = fmt.Sprintf("%d bound agents, %d unbound agents", len(boundAgents), len(agents)-len(boundAgents))
+
+		return len(boundAgents) >= count, nil
+	}, options...)
+
+	return boundAgents, builder.withTimeoutDetails(err, fmt.Sprintf("%d bound agents", count), lastObserved)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	hiveextV1Beta1 "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
@@ -68,19 +69,19 @@ func NewAgentClusterInstallBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the agentclusterinstall is empty")
 
-		builder.errorMsg = "agentclusterinstall 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agentclusterinstall 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the agentclusterinstall is empty")
 
-		builder.errorMsg = "agentclusterinstall 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agentclusterinstall 'namespace' cannot be empty")
 	}
 
 	if clusterDeployment == "" {
 		glog.V(100).Infof("The clusterDeployment ref for the agentclusterinstall is empty")
 
-		builder.errorMsg = "agentclusterinstall 'clusterDeployment' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agentclusterinstall 'clusterDeployment' cannot be empty")
 	}
 
 	return &builder
@@ -95,7 +96,7 @@ func (builder *AgentClusterInstallBuilder) WithAPIVip(apiVIP string) *AgentClust
 	if net.ParseIP(apiVIP) == nil {
 		glog.V(100).Infof("The apiVIP is not a properly formatted IP address")
 
-		builder.errorMsg = "agentclusterinstall apiVIP incorrectly formatted"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agentclusterinstall apiVIP incorrectly formatted")
 	}
 
 	if builder.errorMsg != "" {
@@ -116,7 +117,7 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalAPIVip(apiVIP string) *
 	if net.ParseIP(apiVIP) == nil {
 		glog.V(100).Infof("The apiVIP is not a properly formatted IP address")
 
-		builder.errorMsg = "agentclusterinstall apiVIP incorrectly formatted"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agentclusterinstall apiVIP incorrectly formatted")
 	}
 
 	if builder.errorMsg != "" {
@@ -137,7 +138,7 @@ func (builder *AgentClusterInstallBuilder) WithIngressVip(ingressVIP string) *Ag
 	if net.ParseIP(ingressVIP) == nil {
 		glog.V(100).Infof("The ingressVIP is not a properly formatted IP address")
 
-		builder.errorMsg = "agentclusterinstall ingressVIP incorrectly formatted"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agentclusterinstall ingressVIP incorrectly formatted")
 	}
 
 	if builder.errorMsg != "" {
@@ -158,7 +159,7 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalIngressVip(ingressVIP s
 	if net.ParseIP(ingressVIP) == nil {
 		glog.V(100).Infof("The ingressVIP is not a properly formatted IP address")
 
-		builder.errorMsg = "agentclusterinstall ingressVIP incorrectly formatted"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agentclusterinstall ingressVIP incorrectly formatted")
 	}
 
 	if builder.errorMsg != "" {
@@ -259,7 +260,7 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalClusterNetwork(
 	if _, _, err := net.ParseCIDR(cidr); err != nil {
 		glog.V(100).Infof("The agentclusterinstall passed invalid clusterNetwork cidr: %s", cidr)
 
-		builder.errorMsg = "Got invalid cidr for clusternetwork"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Got invalid cidr for clusternetwork")
 	}
 
 	if builder.errorMsg != "" {
@@ -282,7 +283,7 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalServiceNetwork(cidr str
 	if _, _, err := net.ParseCIDR(cidr); err != nil {
 		glog.V(100).Infof("The agentclusterinstall passed invalid serviceNetwork cidr: %s", cidr)
 
-		builder.errorMsg = "Got invalid cidr for servicenetwork"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Got invalid cidr for servicenetwork")
 	}
 
 	if builder.errorMsg != "" {
@@ -297,57 +298,71 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalServiceNetwork(cidr str
 // WaitForState will wait the defined timeout for the agentclusterinstall to have the defined state.
 func (builder *AgentClusterInstallBuilder) WaitForState(
 	state string,
-	timeout time.Duration) (*AgentClusterInstallBuilder, error) {
+	timeout time.Duration,
+	options ...await.WaitOption) (*AgentClusterInstallBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	// Polls every second to determine if agentclusterinstall in desired state.
-	var err error
-	err = wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	var (
+		err          error
+		lastObserved string
+	)
+
+	err = await.Poll(timeout, func() (bool, error) {
 		builder.Object, err = builder.Get()
 
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("state %q", builder.Object.Status.DebugInfo.State)
+
 		return builder.Object.Status.DebugInfo.State == state, err
 
-	})
+	}, options...)
 
 	if err == nil {
 		return builder, nil
 	}
 
-	return nil, err
+	return nil, builder.withTimeoutDetails(err, fmt.Sprintf("state %q", state), lastObserved)
 }
 
 // WaitForStateInfo will wait the defined timeout for stateInfo to match the defined stateInfo string.
 func (builder *AgentClusterInstallBuilder) WaitForStateInfo(
 	stateInfo string,
-	timeout time.Duration) (*AgentClusterInstallBuilder, error) {
+	timeout time.Duration,
+	options ...await.WaitOption) (*AgentClusterInstallBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	// Polls every second to determine if agentclusterinstall has the desired stateinfo message.
-	var err error
-	err = wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	var (
+		err          error
+		lastObserved string
+	)
+
+	err = await.Poll(timeout, func() (bool, error) {
 		builder.Object, err = builder.Get()
 
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("stateInfo %q", builder.Object.Status.DebugInfo.StateInfo)
+
 		return builder.Object.Status.DebugInfo.StateInfo == stateInfo, err
 
-	})
+	}, options...)
 
 	if err == nil {
 		return builder, nil
 	}
 
-	return nil, err
+	return nil, builder.withTimeoutDetails(err, fmt.Sprintf("stateInfo %q", stateInfo), lastObserved)
 }
 
 // WithOptions creates AgentClusterInstall with generic mutation options.
@@ -361,15 +376,19 @@ func (builder *AgentClusterInstallBuilder) WithOptions(
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -450,7 +469,8 @@ func (builder *AgentClusterInstallBuilder) UpdateCondition(
 func (builder *AgentClusterInstallBuilder) WaitForConditionMessage(
 	condition *agentClusterInstallCondition,
 	message string,
-	timeout time.Duration) (*agentClusterInstallCondition, error) {
+	timeout time.Duration,
+	options ...await.WaitOption) (*agentClusterInstallCondition, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -462,28 +482,36 @@ func (builder *AgentClusterInstallBuilder) WaitForConditionMessage(
 	glog.V(100).Infof("Waiting for message '%s' on condition %s in agentclusterinstall %s",
 		message, condition.Type, builder.Definition.Name)
 
+	var lastObserved string
+
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if agentclusterinstall validation has desired status.
-	err := wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 		_, err := builder.UpdateCondition(condition)
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("condition %s message %q", condition.Type, condition.Message)
+
 		return condition.Message == message, err
-	})
+	}, options...)
 
 	if err == nil {
 		return condition, nil
 	}
 
-	return nil, err
+	return nil, builder.withTimeoutDetails(
+		err, fmt.Sprintf("condition %s message %q", condition.Type, message), lastObserved)
 }
 
 // WaitForConditionStatus waits the specified timeout for the given condition to report the specified status.
 func (builder *AgentClusterInstallBuilder) WaitForConditionStatus(
 	condition *agentClusterInstallCondition,
 	status string,
-	timeout time.Duration) (*agentClusterInstallCondition, error) {
+	timeout time.Duration,
+	options ...await.WaitOption) (*agentClusterInstallCondition, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -495,24 +523,32 @@ func (builder *AgentClusterInstallBuilder) WaitForConditionStatus(
 	glog.V(100).Infof("Waiting for status '%s' on condition %s in agentclusterinstall %s",
 		status, condition.Type, builder.Definition.Name)
 
+	var lastObserved string
+
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if agentclusterinstall validation has desired status.
-	err := wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 		_, err := builder.UpdateCondition(condition)
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("condition %s status %q", condition.Type, string(condition.Status))
+
 		return string(condition.Status) == status, err
-	})
+	}, options...)
 
-	return condition, err
+	return condition, builder.withTimeoutDetails(
+		err, fmt.Sprintf("condition %s status %q", condition.Type, status), lastObserved)
 }
 
 // WaitForConditionReason waits the specified timeout for the given condition to report the specified reason.
 func (builder *AgentClusterInstallBuilder) WaitForConditionReason(
 	condition *agentClusterInstallCondition,
 	reason string,
-	timeout time.Duration) (*agentClusterInstallCondition, error) {
+	timeout time.Duration,
+	options ...await.WaitOption) (*agentClusterInstallCondition, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -524,17 +560,24 @@ func (builder *AgentClusterInstallBuilder) WaitForConditionReason(
 	glog.V(100).Infof("Waiting for reason '%s' on condition %s in agentclusterinstall %s",
 		reason, condition.Type, builder.Definition.Name)
 
+	var lastObserved string
+
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if agentclusterinstall validation has desired status.
-	err := wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 		_, err := builder.UpdateCondition(condition)
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("condition %s reason %q", condition.Type, condition.Reason)
+
 		return condition.Reason == reason, err
-	})
+	}, options...)
 
-	return condition, err
+	return condition, builder.withTimeoutDetails(
+		err, fmt.Sprintf("condition %s reason %q", condition.Type, reason), lastObserved)
 }
 
 // Get fetches the defined agentclusterinstall from the cluster.
@@ -577,13 +620,13 @@ func PullAgentClusterInstall(apiClient *clients.Settings, name, nsname string) (
 	if name == "" {
 		glog.V(100).Infof("The name of the agentclusterinstall is empty")
 
-		builder.errorMsg = "agentclusterinstall 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agentclusterinstall 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the agentclusterinstall is empty")
 
-		builder.errorMsg = "agentclusterinstall 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agentclusterinstall 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -625,7 +668,7 @@ func (builder *AgentClusterInstallBuilder) Update(force bool) (*AgentClusterInst
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
-		builder.errorMsg = "Cannot update non-existent agentclusterinstall"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Cannot update non-existent agentclusterinstall")
 	}
 
 	if builder.errorMsg != "" {
@@ -688,7 +731,7 @@ func (builder *AgentClusterInstallBuilder) Delete() (*AgentClusterInstallBuilder
 }
 
 // DeleteAndWait deletes an agentclusterinstall and waits until it is removed from the cluster.
-func (builder *AgentClusterInstallBuilder) DeleteAndWait(timeout time.Duration) error {
+func (builder *AgentClusterInstallBuilder) DeleteAndWait(timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -702,7 +745,7 @@ func (builder *AgentClusterInstallBuilder) DeleteAndWait(timeout time.Duration)
 	}
 
 	// Polls the agentclusterinstall every second until it's removed.
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 		_, err := builder.Get()
 		if k8serrors.IsNotFound(err) {
 
@@ -710,7 +753,9 @@ func (builder *AgentClusterInstallBuilder) DeleteAndWait(timeout time.Duration)
 		}
 
 		return false, nil
-	})
+	}, options...)
+
+	return builder.withTimeoutDetails(err, "deletion", "object still present")
 }
 
 // Exists checks if the defined agentclusterinstall has already been created.
@@ -779,6 +824,17 @@ func (builder *AgentClusterInstallBuilder) waitForConditions(
 	return err
 }
 
+// withTimeoutDetails converts a wait timeout into an await.WaitTimeoutError referencing the agentclusterinstall.
+func (builder *AgentClusterInstallBuilder) withTimeoutDetails(err error, wanted, lastObserved string) error {
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          hiveextV1Beta1.GroupVersion.WithKind("AgentClusterInstall"),
+		Name:         builder.Definition.Name,
+		Namespace:    builder.Definition.Namespace,
+		Wanted:       wanted,
+		LastObserved: lastObserved,
+	})
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *AgentClusterInstallBuilder) validate() (bool, error) {
@@ -793,13 +849,14 @@ func (builder *AgentClusterInstallBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
@@ -13,7 +14,6 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -82,7 +82,7 @@ func NewDefaultAgentServiceConfigBuilder(apiClient *clients.Settings) *AgentServ
 	if err != nil {
 		glog.V(100).Infof("The ImageStorage size is in wrong format")
 
-		builder.errorMsg = fmt.Sprintf("error retrieving the storage size: %v", err)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf("error retrieving the storage size: %v", err))
 	}
 
 	builder.Definition.Spec.ImageStorage = &imageStorageSpec
@@ -91,7 +91,7 @@ func NewDefaultAgentServiceConfigBuilder(apiClient *clients.Settings) *AgentServ
 	if err != nil {
 		glog.V(100).Infof("The DatabaseStorage size is in wrong format")
 
-		builder.errorMsg = fmt.Sprintf("error retrieving the storage size: %v", err)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf("error retrieving the storage size: %v", err))
 	}
 
 	builder.Definition.Spec.DatabaseStorage = databaseStorageSpec
@@ -100,7 +100,7 @@ func NewDefaultAgentServiceConfigBuilder(apiClient *clients.Settings) *AgentServ
 	if err != nil {
 		glog.V(100).Infof("The FileSystemStorage size is in wrong format")
 
-		builder.errorMsg = fmt.Sprintf("error retrieving the storage size: %v", err)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf("error retrieving the storage size: %v", err))
 	}
 
 	builder.Definition.Spec.FileSystemStorage = fileSystemStorageSpec
@@ -133,7 +133,8 @@ func (builder *AgentServiceConfigBuilder) WithMirrorRegistryRef(configMapName st
 	if configMapName == "" {
 		glog.V(100).Infof("The configMapName is empty")
 
-		builder.errorMsg = "cannot add agentserviceconfig mirrorRegistryRef with empty configmap name"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "cannot add agentserviceconfig mirrorRegistryRef with empty configmap name")
 	}
 
 	if builder.errorMsg != "" {
@@ -197,15 +198,19 @@ func (builder *AgentServiceConfigBuilder) WithOptions(
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -213,7 +218,8 @@ func (builder *AgentServiceConfigBuilder) WithOptions(
 }
 
 // WaitUntilDeployed waits the specified timeout for the agentserviceconfig to deploy.
-func (builder *AgentServiceConfigBuilder) WaitUntilDeployed(timeout time.Duration) (*AgentServiceConfigBuilder, error) {
+func (builder *AgentServiceConfigBuilder) WaitUntilDeployed(
+	timeout time.Duration, options ...await.WaitOption) (*AgentServiceConfigBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -223,24 +229,31 @@ func (builder *AgentServiceConfigBuilder) WaitUntilDeployed(timeout time.Duratio
 	if builder.Definition == nil {
 		glog.V(100).Infof("The agentserviceconfig is undefined")
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString("AgentServiceConfig")
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString("AgentServiceConfig"))
 	}
 
 	if !builder.Exists() {
 		glog.V(100).Infof("The agentserviceconfig does not exist on the cluster")
 
-		builder.errorMsg = "cannot wait for non-existent agentserviceconfig to be deployed"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "cannot wait for non-existent agentserviceconfig to be deployed")
 	}
 
 	if builder.errorMsg != "" {
 		return builder, fmt.Errorf(builder.errorMsg)
 	}
 
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if agentserviceconfig is in desired state.
 	conditionIndex := -1
 
-	var err error
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	var (
+		err          error
+		lastObserved string
+	)
+
+	err = await.Poll(timeout, func() (bool, error) {
 		builder.Object, err = builder.Get()
 
 		if err != nil {
@@ -256,17 +269,23 @@ func (builder *AgentServiceConfigBuilder) WaitUntilDeployed(timeout time.Duratio
 		}
 
 		if conditionIndex < 0 {
+			lastObserved = fmt.Sprintf("condition %s missing", agentInstallV1Beta1.ConditionDeploymentsHealthy)
+
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("condition %s %s", agentInstallV1Beta1.ConditionDeploymentsHealthy,
+			builder.Object.Status.Conditions[conditionIndex].Status)
+
 		return builder.Object.Status.Conditions[conditionIndex].Status == "True", nil
-	})
+	}, options...)
 
 	if err == nil {
 		return builder, nil
 	}
 
-	return nil, err
+	return nil, builder.withTimeoutDetails(
+		err, fmt.Sprintf("condition %s True", agentInstallV1Beta1.ConditionDeploymentsHealthy), lastObserved)
 }
 
 // PullAgentServiceConfig loads the existing agentserviceconfig into AgentServiceConfigBuilder struct.
@@ -346,7 +365,7 @@ func (builder *AgentServiceConfigBuilder) Update(force bool) (*AgentServiceConfi
 		glog.V(100).Infof("agentserviceconfig %s does not exist",
 			builder.Definition.Name)
 
-		builder.errorMsg = "Cannot update non-existent agentserviceconfig"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Cannot update non-existent agentserviceconfig")
 	}
 
 	if builder.errorMsg != "" {
@@ -410,7 +429,7 @@ func (builder *AgentServiceConfigBuilder) Delete() (*AgentServiceConfigBuilder,
 }
 
 // DeleteAndWait deletes an agentserviceconfig and waits until it is removed from the cluster.
-func (builder *AgentServiceConfigBuilder) DeleteAndWait(timeout time.Duration) error {
+func (builder *AgentServiceConfigBuilder) DeleteAndWait(timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -424,7 +443,7 @@ func (builder *AgentServiceConfigBuilder) DeleteAndWait(timeout time.Duration) e
 	}
 
 	// Polls the agentserviceconfig every second until it's removed.
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 		_, err := builder.Get()
 		if k8serrors.IsNotFound(err) {
 
@@ -432,7 +451,9 @@ func (builder *AgentServiceConfigBuilder) DeleteAndWait(timeout time.Duration) e
 		}
 
 		return false, nil
-	})
+	}, options...)
+
+	return builder.withTimeoutDetails(err, "deletion", "object still present")
 }
 
 // Exists checks if the defined agentserviceconfig has already been created.
@@ -474,6 +495,16 @@ func GetDefaultStorageSpec(defaultStorageSize string) (corev1.PersistentVolumeCl
 	return defaultSpec, nil
 }
 
+// withTimeoutDetails converts a wait timeout into an await.WaitTimeoutError referencing the agentserviceconfig.
+func (builder *AgentServiceConfigBuilder) withTimeoutDetails(err error, wanted, lastObserved string) error {
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          agentInstallV1Beta1.GroupVersion.WithKind("AgentServiceConfig"),
+		Name:         builder.Definition.Name,
+		Wanted:       wanted,
+		LastObserved: lastObserved,
+	})
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *AgentServiceConfigBuilder) validate() (bool, error) {
@@ -488,13 +519,14 @@ func (builder *AgentServiceConfigBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -8,6 +8,7 @@ import (
 	"math/rand"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	hiveextV1Beta1 "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
@@ -16,7 +17,6 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -63,19 +63,19 @@ func NewInfraEnvBuilder(apiClient *clients.Settings, name, nsname, psName string
 	if name == "" {
 		glog.V(100).Infof("The name of the infraenv is empty")
 
-		builder.errorMsg = "infraenv 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "infraenv 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the infraenv is empty")
 
-		builder.errorMsg = "infraenv 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "infraenv 'namespace' cannot be empty")
 	}
 
 	if psName == "" {
 		glog.V(100).Infof("The pull-secret ref of the infraenv is empty")
 
-		builder.errorMsg = "infraenv 'pull-secret' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "infraenv 'pull-secret' cannot be empty")
 	}
 
 	return &builder
@@ -92,13 +92,13 @@ func (builder *InfraEnvBuilder) WithClusterRef(name, nsname string) *InfraEnvBui
 	if name == "" {
 		glog.V(100).Infof("The name of the infraenv clusterRef is empty")
 
-		builder.errorMsg = "infraenv clusterRef 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "infraenv clusterRef 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the infraenv clusterRef is empty")
 
-		builder.errorMsg = "infraenv clusterRef 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "infraenv clusterRef 'namespace' cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -246,15 +246,19 @@ func (builder *InfraEnvBuilder) WithOptions(
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -262,29 +266,38 @@ func (builder *InfraEnvBuilder) WithOptions(
 }
 
 // WaitForDiscoveryISOCreation waits the defined timeout for the discovery ISO to be generated.
-func (builder *InfraEnvBuilder) WaitForDiscoveryISOCreation(timeout time.Duration) (*InfraEnvBuilder, error) {
+func (builder *InfraEnvBuilder) WaitForDiscoveryISOCreation(
+	timeout time.Duration, options ...await.WaitOption) (*InfraEnvBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if infraenv in desired state.
-	var err error
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	var (
+		err          error
+		lastObserved string
+	)
+
+	err = await.Poll(timeout, func() (bool, error) {
 		builder.Object, err = builder.Get()
 
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("discovery ISO created %t", builder.Object.Status.CreatedTime != nil)
+
 		return builder.Object.Status.CreatedTime != nil, nil
 
-	})
+	}, options...)
 
 	if err == nil {
 		return builder, nil
 	}
 
-	return nil, err
+	return nil, builder.withTimeoutDetails(err, "discovery ISO created", lastObserved)
 }
 
 // GetAllAgents returns a slice of agentBuilders of all agents belonging to the infraenv.
@@ -420,7 +433,8 @@ func (builder *InfraEnvBuilder) GetAgentsByLabel(key, value string) ([]*agentBui
 
 // WaitForAgentsToRegister waits the specified time for agents to register
 // matching the provisioninRequirements of the related AgentClusterInstall.
-func (builder *InfraEnvBuilder) WaitForAgentsToRegister(timeout time.Duration) ([]*agentBuilder, error) {
+func (builder *InfraEnvBuilder) WaitForAgentsToRegister(
+	timeout time.Duration, options ...await.WaitOption) ([]*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -435,13 +449,18 @@ func (builder *InfraEnvBuilder) WaitForAgentsToRegister(timeout time.Duration) (
 		return nil, err
 	}
 
-	var agentList []*agentBuilder
+	var (
+		agentList    []*agentBuilder
+		lastObserved string
+	)
 
 	agentCount := agentclusterinstall.Spec.ProvisionRequirements.ControlPlaneAgents +
 		agentclusterinstall.Spec.ProvisionRequirements.WorkerAgents
 
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if agent has registered.
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err = await.Poll(timeout, func() (bool, error) {
 
 		agentList, err = builder.GetAllAgents()
 
@@ -449,15 +468,18 @@ func (builder *InfraEnvBuilder) WaitForAgentsToRegister(timeout time.Duration) (
 			return false, err
 		}
 
+		lastObserved = fmt.Sprintf("%d agents", len(agentList))
+
 		return len(agentList) == agentCount, nil
-	})
+	}, options...)
 
-	return agentList, err
+	return agentList, builder.withTimeoutDetails(err, fmt.Sprintf("%d registered agents", agentCount), lastObserved)
 }
 
 // WaitForMasterAgents waits the specified time for agents with the role master
 // to register and match the ControlPlaneAgents count in ProvisionRequirements of the related AgentClusterInstall.
-func (builder *InfraEnvBuilder) WaitForMasterAgents(timeout time.Duration) ([]*agentBuilder, error) {
+func (builder *InfraEnvBuilder) WaitForMasterAgents(
+	timeout time.Duration, options ...await.WaitOption) ([]*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -468,35 +490,48 @@ func (builder *InfraEnvBuilder) WaitForMasterAgents(timeout time.Duration) ([]*a
 		return nil, err
 	}
 
-	var agentList []*agentBuilder
+	var (
+		agentList    []*agentBuilder
+		lastObserved string
+	)
 
 	agentCount := agentclusterinstall.Spec.ProvisionRequirements.ControlPlaneAgents
 
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if agent has registered.
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err = await.Poll(timeout, func() (bool, error) {
 		agentList, err = builder.GetAgentsByRole("master")
 		if err != nil {
 
 			return false, err
 		}
 
+		lastObserved = fmt.Sprintf("%d agents", len(agentList))
+
 		return len(agentList) == agentCount, nil
-	})
+	}, options...)
 
-	return agentList, err
+	return agentList, builder.withTimeoutDetails(err, fmt.Sprintf("%d master agents", agentCount), lastObserved)
 }
 
 // WaitForMasterAgentCount waits the specified time for agents
 // with the role master to register and match the specified count.
-func (builder *InfraEnvBuilder) WaitForMasterAgentCount(count int, timeout time.Duration) ([]*agentBuilder, error) {
+func (builder *InfraEnvBuilder) WaitForMasterAgentCount(
+	count int, timeout time.Duration, options ...await.WaitOption) ([]*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	var agentList []*agentBuilder
+	var (
+		agentList    []*agentBuilder
+		lastObserved string
+	)
+
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
 
 	// Polls every retryInterval to determine if agent has registered.
-	err := wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 
 		agentList, err := builder.GetAgentsByRole("master")
 		if err != nil {
@@ -504,10 +539,12 @@ func (builder *InfraEnvBuilder) WaitForMasterAgentCount(count int, timeout time.
 			return false, err
 		}
 
+		lastObserved = fmt.Sprintf("%d agents", len(agentList))
+
 		return len(agentList) == count, nil
-	})
+	}, options...)
 
-	return agentList, err
+	return agentList, builder.withTimeoutDetails(err, fmt.Sprintf("%d master agents", count), lastObserved)
 }
 
 // GetRandomMasterAgent returns an agentBuilder of a random agent that has it's role set to master.
@@ -534,7 +571,8 @@ func (builder *InfraEnvBuilder) GetRandomMasterAgent() (*agentBuilder, error) {
 
 // WaitForWorkerAgents waits the specified time for agents with the role worker to register and match the WorkerAgents
 // count in ProvisionRequirements of the related AgentClusterInstall.
-func (builder *InfraEnvBuilder) WaitForWorkerAgents(timeout time.Duration) ([]*agentBuilder, error) {
+func (builder *InfraEnvBuilder) WaitForWorkerAgents(
+	timeout time.Duration, options ...await.WaitOption) ([]*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -545,12 +583,17 @@ func (builder *InfraEnvBuilder) WaitForWorkerAgents(timeout time.Duration) ([]*a
 		return nil, err
 	}
 
-	var agentList []*agentBuilder
+	var (
+		agentList    []*agentBuilder
+		lastObserved string
+	)
 
 	agentCount := agentclusterinstall.Spec.ProvisionRequirements.WorkerAgents
 
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if agent has registered.
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err = await.Poll(timeout, func() (bool, error) {
 
 		agentList, err = builder.GetAgentsByRole("worker")
 		if err != nil {
@@ -558,23 +601,31 @@ func (builder *InfraEnvBuilder) WaitForWorkerAgents(timeout time.Duration) ([]*a
 			return false, err
 		}
 
+		lastObserved = fmt.Sprintf("%d agents", len(agentList))
+
 		return len(agentList) == agentCount, nil
-	})
+	}, options...)
 
-	return agentList, err
+	return agentList, builder.withTimeoutDetails(err, fmt.Sprintf("%d worker agents", agentCount), lastObserved)
 }
 
 // WaitForWorkerAgentCount waits the specified time
 // for agents with the role worker to register and match the specified count.
-func (builder *InfraEnvBuilder) WaitForWorkerAgentCount(count int, timeout time.Duration) ([]*agentBuilder, error) {
+func (builder *InfraEnvBuilder) WaitForWorkerAgentCount(
+	count int, timeout time.Duration, options ...await.WaitOption) ([]*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	var agentList []*agentBuilder
+	var (
+		agentList    []*agentBuilder
+		lastObserved string
+	)
+
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
 
 	// Polls every retryInterval to determine if agent has registered.
-	err := wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 
 		agentList, err := builder.GetAgentsByRole("worker")
 		if err != nil {
@@ -582,10 +633,12 @@ func (builder *InfraEnvBuilder) WaitForWorkerAgentCount(count int, timeout time.
 			return false, err
 		}
 
+		lastObserved = fmt.Sprintf("%d agents", len(agentList))
+
 		return len(agentList) == count, nil
-	})
+	}, options...)
 
-	return agentList, err
+	return agentList, builder.withTimeoutDetails(err, fmt.Sprintf("%d worker agents", count), lastObserved)
 }
 
 // GetRandomWorkerAgent returns an agentBuilder of a random agent that has it's role set to worker.
@@ -715,13 +768,13 @@ func PullInfraEnvInstall(apiClient *clients.Settings, name, nsname string) (*Inf
 	if name == "" {
 		glog.V(100).Infof("The name of the infraenv is empty")
 
-		builder.errorMsg = "infraenv 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "infraenv 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the infraenv is empty")
 
-		builder.errorMsg = "infraenv 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "infraenv 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -766,7 +819,7 @@ func (builder *InfraEnvBuilder) Update(force bool) (*InfraEnvBuilder, error) {
 		glog.V(100).Infof("infraenv %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "Cannot update non-existent infraenv"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Cannot update non-existent infraenv")
 	}
 
 	if builder.errorMsg != "" {
@@ -842,6 +895,17 @@ func (builder *InfraEnvBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// withTimeoutDetails converts a wait timeout into an await.WaitTimeoutError referencing the infraenv.
+func (builder *InfraEnvBuilder) withTimeoutDetails(err error, wanted, lastObserved string) error {
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          agentInstallV1Beta1.GroupVersion.WithKind("InfraEnv"),
+		Name:         builder.Definition.Name,
+		Namespace:    builder.Definition.Namespace,
+		Wanted:       wanted,
+		LastObserved: lastObserved,
+	})
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *InfraEnvBuilder) validate() (bool, error) {
@@ -856,13 +920,14 @@ func (builder *InfraEnvBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -44,13 +44,13 @@ func NewNmStateConfigBuilder(apiClient *clients.Settings, name, namespace string
 	if name == "" {
 		glog.V(100).Infof("The name of the nmstateconfig is empty")
 
-		builder.errorMsg = "nmstateconfig 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "nmstateconfig 'name' cannot be empty")
 	}
 
 	if namespace == "" {
 		glog.V(100).Infof("The namespace of the nmstateconfig is empty")
 
-		builder.errorMsg = "nmstateconfig namespace's name is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "nmstateconfig namespace's name is empty")
 	}
 
 	return &builder
@@ -137,6 +137,12 @@ func (builder *NmStateConfigBuilder) Delete() (*NmStateConfigBuilder, error) {
 
 // ListNmStateConfigsInAllNamespaces returns a cluster-wide NMStateConfig list.
 func ListNmStateConfigsInAllNamespaces(apiClient *clients.Settings) ([]*NmStateConfigBuilder, error) {
+	if apiClient == nil {
+		glog.V(100).Infof("nmStateConfigs 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list nmStateConfigs, 'apiClient' parameter is empty")
+	}
+
 	nmStateConfigList := &assistedv1beta1.NMStateConfigList{}
 
 	err := apiClient.List(context.Background(), nmStateConfigList, &goclient.ListOptions{})
@@ -165,6 +171,12 @@ func ListNmStateConfigsInAllNamespaces(apiClient *clients.Settings) ([]*NmStateC
 
 // ListNmStateConfigs returns a NMStateConfig list in a given namespace.
 func ListNmStateConfigs(apiClient *clients.Settings, namespace string) ([]*NmStateConfigBuilder, error) {
+	if apiClient == nil {
+		glog.V(100).Infof("nmStateConfigs 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list nmStateConfigs, 'apiClient' parameter is empty")
+	}
+
 	nmStateConfigList := &assistedv1beta1.NMStateConfigList{}
 
 	if namespace == "" {
@@ -210,13 +222,14 @@ func (builder *NmStateConfigBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
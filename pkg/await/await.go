@@ -0,0 +1,127 @@
+package await
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// WaitFunc is a named wait operation, usually wrapping a builder Wait method such as bmhBuilder.WaitUntilReady.
+// The name is used to identify the waited object in the returned errors.
+type WaitFunc struct {
+	Name string
+	Wait func(timeout time.Duration) error
+}
+
+// All runs all waitFuncs concurrently with the same timeout and blocks until every one of them returned.
+// The returned error aggregates the errors of all the failed waits, each prefixed by its name.
+func All(timeout time.Duration, waitFuncs ...WaitFunc) error {
+	return AllWithConcurrency(timeout, len(waitFuncs), waitFuncs...)
+}
+
+// AllWithConcurrency behaves like All with at most maxConcurrency waits running at the same time, for bulk waits on
+// many objects. Waits are started in the given order and each of them gets the full timeout once started.
+func AllWithConcurrency(timeout time.Duration, maxConcurrency int, waitFuncs ...WaitFunc) error {
+	glog.V(100).Infof("Waiting for %d operations to complete within %s with a concurrency of %d",
+		len(waitFuncs), timeout, maxConcurrency)
+
+	if err := validateWaitFuncs(waitFuncs); err != nil {
+		return err
+	}
+
+	if maxConcurrency < 1 {
+		glog.V(100).Infof("The concurrency %d is less than 1", maxConcurrency)
+
+		return fmt.Errorf("maxConcurrency must be at least 1, got %d", maxConcurrency)
+	}
+
+	errs := make([]error, len(waitFuncs))
+	slots := make(chan struct{}, maxConcurrency)
+
+	var waitGroup sync.WaitGroup
+
+	for index, waitFunc := range waitFuncs {
+		slots <- struct{}{}
+
+		waitGroup.Add(1)
+
+		go func(index int, waitFunc WaitFunc) {
+			defer func() {
+				<-slots
+				waitGroup.Done()
+			}()
+
+			if err := waitFunc.Wait(timeout); err != nil {
+				glog.V(100).Infof("Wait for %s failed: %s", waitFunc.Name, err.Error())
+
+				errs[index] = fmt.Errorf("%s: %w", waitFunc.Name, err)
+			}
+		}(index, waitFunc)
+	}
+
+	waitGroup.Wait()
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Any runs all waitFuncs concurrently with the same timeout and returns as soon as one of them succeeded.
+// If every wait failed the returned error aggregates all of their errors, each prefixed by its name.
+// Waits still running when Any returns are not interrupted and keep running until their own timeout.
+func Any(timeout time.Duration, waitFuncs ...WaitFunc) error {
+	glog.V(100).Infof("Waiting for any of %d operations to complete within %s", len(waitFuncs), timeout)
+
+	if err := validateWaitFuncs(waitFuncs); err != nil {
+		return err
+	}
+
+	results := make(chan error, len(waitFuncs))
+
+	for _, waitFunc := range waitFuncs {
+		go func(waitFunc WaitFunc) {
+			if err := waitFunc.Wait(timeout); err != nil {
+				glog.V(100).Infof("Wait for %s failed: %s", waitFunc.Name, err.Error())
+
+				results <- fmt.Errorf("%s: %w", waitFunc.Name, err)
+
+				return
+			}
+
+			results <- nil
+		}(waitFunc)
+	}
+
+	var errs []error
+
+	for range waitFuncs {
+		err := <-results
+		if err == nil {
+			return nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// validateWaitFuncs makes sure that at least one wait was provided and that none of them is undefined.
+func validateWaitFuncs(waitFuncs []WaitFunc) error {
+	if len(waitFuncs) == 0 {
+		glog.V(100).Infof("No wait functions were provided")
+
+		return fmt.Errorf("at least one wait function must be provided")
+	}
+
+	for _, waitFunc := range waitFuncs {
+		if waitFunc.Wait == nil {
+			glog.V(100).Infof("The wait function %s is nil", waitFunc.Name)
+
+			return fmt.Errorf("wait function %s cannot be nil", waitFunc.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package await
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// WaitTimeoutError is returned by the builder Wait* methods when the object did not reach the wanted state in time.
+// It wraps wait.ErrWaitTimeout, so errors.Is(err, wait.ErrWaitTimeout) keeps matching.
+type WaitTimeoutError struct {
+	GVK schema.GroupVersionKind
+	// Name is empty for waits on all the objects of a kind in Namespace.
+	Name      string
+	Namespace string
+	// Wanted describes the state the object was waited for, e.g. "provisioning state provisioned".
+	Wanted string
+	// LastObserved describes the last state seen before the timeout. It is empty if the object was never read.
+	LastObserved string
+	// Err is the underlying timeout error.
+	Err error
+}
+
+// Error implements the error interface.
+func (timeoutErr *WaitTimeoutError) Error() string {
+	kind := timeoutErr.GVK.Kind
+	if kind == "" {
+		kind = "object"
+	}
+
+	switch {
+	case timeoutErr.Name != "" && timeoutErr.Namespace != "":
+		kind = fmt.Sprintf("%s %s/%s", kind, timeoutErr.Namespace, timeoutErr.Name)
+	case timeoutErr.Name != "":
+		kind = fmt.Sprintf("%s %s", kind, timeoutErr.Name)
+	case timeoutErr.Namespace != "":
+		kind = fmt.Sprintf("%s objects in namespace %s", kind, timeoutErr.Namespace)
+	}
+
+	lastObserved := timeoutErr.LastObserved
+	if lastObserved == "" {
+		lastObserved = "nothing"
+	}
+
+	return fmt.Sprintf("timed out waiting for %s to reach %s, last observed %s",
+		kind, timeoutErr.Wanted, lastObserved)
+}
+
+// Unwrap returns the underlying timeout error.
+func (timeoutErr *WaitTimeoutError) Unwrap() error {
+	return timeoutErr.Err
+}
+
+// WithTimeoutDetails returns details as a *WaitTimeoutError wrapping err if err is a wait timeout. Any other error,
+// including nil, is returned unchanged.
+func WithTimeoutDetails(err error, details WaitTimeoutError) error {
+	if err == nil || !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+
+	details.Err = err
+
+	return &details
+}
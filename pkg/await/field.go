@@ -0,0 +1,199 @@
+package await
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// ObjectGetter returns the current state of an object, e.g. a closure around the Get method of a builder.
+type ObjectGetter func() (interface{}, error)
+
+// GetField returns the value at the given JSONPath of object, formatted as kubectl -o jsonpath does. The path may
+// be given with or without the surrounding braces, e.g. ".status.phase" or "{.status.phase}". Typed objects are
+// converted using their JSON field names.
+func GetField(object interface{}, path string) (string, error) {
+	if object == nil {
+		return "", fmt.Errorf("cannot get field %s of nil object", path)
+	}
+
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+
+	parser := jsonpath.New("field")
+	if err := parser.Parse(path); err != nil {
+		return "", fmt.Errorf("invalid JSONPath %s: %w", path, err)
+	}
+
+	data, err := toUnstructuredContent(object)
+	if err != nil {
+		return "", err
+	}
+
+	var output bytes.Buffer
+
+	if err := parser.Execute(&output, data); err != nil {
+		return "", fmt.Errorf("failed to get field %s: %w", path, err)
+	}
+
+	return output.String(), nil
+}
+
+// WaitForField waits for timeout duration or until the value at the given JSONPath of the object returned by
+// getObject equals wanted. It is an escape hatch for status fields not covered by the builder Wait* methods. On
+// timeout a *WaitTimeoutError identifying the last object read is returned.
+func WaitForField(
+	getObject ObjectGetter, path, wanted string, timeout time.Duration, options ...WaitOption) error {
+	if getObject == nil {
+		glog.V(100).Infof("The object getter is nil")
+
+		return fmt.Errorf("object getter cannot be nil")
+	}
+
+	glog.V(100).Infof("Waiting for field %s to be %q", path, wanted)
+
+	var (
+		lastObserved string
+		lastObject   interface{}
+	)
+
+	err := Poll(timeout, func() (bool, error) {
+		object, err := getObject()
+		if err != nil {
+			glog.V(100).Infof("Failed to get object: %s", err.Error())
+
+			return false, nil
+		}
+
+		lastObject = object
+
+		value, err := GetField(object, path)
+		if err != nil {
+			glog.V(100).Infof("Failed to get field %s: %s", path, err.Error())
+
+			return false, nil
+		}
+
+		lastObserved = fmt.Sprintf("%s %q", path, value)
+
+		return value == wanted, nil
+	}, options...)
+
+	details := describeObject(lastObject)
+	details.Wanted = fmt.Sprintf("%s %q", path, wanted)
+	details.LastObserved = lastObserved
+
+	return WithTimeoutDetails(err, details)
+}
+
+// WaitForResourceField waits for timeout duration or until the value at the given JSONPath of the resource read
+// through the dynamic client equals wanted. An empty nsname reads a cluster scoped resource.
+func WaitForResourceField(
+	client dynamic.Interface,
+	gvr schema.GroupVersionResource,
+	name string,
+	nsname string,
+	path string,
+	wanted string,
+	timeout time.Duration,
+	options ...WaitOption) error {
+	if client == nil {
+		glog.V(100).Infof("The dynamic client is nil")
+
+		return fmt.Errorf("dynamic client cannot be nil")
+	}
+
+	var lastObserved string
+
+	// The kind is only known once the object was read, until then the error only carries the group and version.
+	gvk := gvr.GroupVersion().WithKind("")
+
+	err := Poll(timeout, func() (bool, error) {
+		object, err := client.Resource(gvr).Namespace(nsname).Get(context.TODO(), name, metaV1.GetOptions{})
+		if err != nil {
+			glog.V(100).Infof("Failed to get %s %s: %s", gvr.Resource, name, err.Error())
+
+			return false, nil
+		}
+
+		gvk = object.GroupVersionKind()
+
+		value, err := GetField(object.Object, path)
+		if err != nil {
+			glog.V(100).Infof("Failed to get field %s: %s", path, err.Error())
+
+			return false, nil
+		}
+
+		lastObserved = fmt.Sprintf("%s %q", path, value)
+
+		return value == wanted, nil
+	}, options...)
+
+	return WithTimeoutDetails(err, WaitTimeoutError{
+		GVK:          gvk,
+		Name:         name,
+		Namespace:    nsname,
+		Wanted:       fmt.Sprintf("%s %q", path, wanted),
+		LastObserved: lastObserved,
+	})
+}
+
+// describeObject returns the timeout details identifying object, i.e. its kind, name and namespace. The kind of typed
+// objects whose TypeMeta is not set, as returned by the typed clientsets, is taken from their Go type.
+func describeObject(object interface{}) WaitTimeoutError {
+	var details WaitTimeoutError
+
+	if content, ok := object.(map[string]interface{}); ok {
+		object = &unstructured.Unstructured{Object: content}
+	}
+
+	if accessor, err := meta.Accessor(object); err == nil {
+		details.Name = accessor.GetName()
+		details.Namespace = accessor.GetNamespace()
+	}
+
+	if runtimeObject, ok := object.(runtime.Object); ok {
+		details.GVK = runtimeObject.GetObjectKind().GroupVersionKind()
+	}
+
+	if details.GVK.Kind == "" && object != nil {
+		if objectType := reflect.TypeOf(object); objectType.Kind() == reflect.Pointer {
+			details.GVK.Kind = objectType.Elem().Name()
+		}
+	}
+
+	return details
+}
+
+// toUnstructuredContent converts object into the map representation used by the JSONPath parser.
+func toUnstructuredContent(object interface{}) (interface{}, error) {
+	switch typedObject := object.(type) {
+	case map[string]interface{}:
+		return typedObject, nil
+	case *unstructured.Unstructured:
+		return typedObject.Object, nil
+	case unstructured.Unstructured:
+		return typedObject.Object, nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert object to unstructured: %w", err)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,183 @@
+package await
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DefaultInterval is the polling interval used when no WithInterval option is provided.
+const DefaultInterval = time.Second
+
+// WaitOption customizes how Poll checks its condition.
+type WaitOption func(config *waitConfig)
+
+type waitConfig struct {
+	interval      time.Duration
+	backoffFactor float64
+	maxInterval   time.Duration
+	logProgress   bool
+	description   string
+	ctx           context.Context
+}
+
+// WithInterval sets the time between two condition checks. Non-positive intervals are ignored.
+func WithInterval(interval time.Duration) WaitOption {
+	return func(config *waitConfig) {
+		if interval > 0 {
+			config.interval = interval
+		}
+	}
+}
+
+// WithBackoff multiplies the interval by factor after every unsuccessful check, up to maxInterval. A zero
+// maxInterval means the interval is not capped. Factors less than or equal to 1 are ignored.
+func WithBackoff(factor float64, maxInterval time.Duration) WaitOption {
+	return func(config *waitConfig) {
+		if factor > 1 {
+			config.backoffFactor = factor
+			config.maxInterval = maxInterval
+		}
+	}
+}
+
+// WithProgressLogging logs every unsuccessful check together with the remaining time, using description to
+// identify the wait.
+func WithProgressLogging(description string) WaitOption {
+	return func(config *waitConfig) {
+		config.logProgress = true
+		config.description = description
+	}
+}
+
+// WithContext stops the wait as soon as ctx is done and returns the error of ctx, so that test frameworks can
+// enforce their own deadlines and cancellation on long waits. A nil ctx is ignored.
+func WithContext(ctx context.Context) WaitOption {
+	return func(config *waitConfig) {
+		if ctx != nil {
+			config.ctx = ctx
+		}
+	}
+}
+
+// Poll checks condition immediately and then at the configured interval until it returns true, returns an error
+// or timeout elapses. On timeout wait.ErrWaitTimeout is returned so that callers relying on the
+// k8s.io/apimachinery wait package behavior are not affected. The error of the context passed with WithContext
+// is returned as soon as it is done.
+func Poll(timeout time.Duration, condition wait.ConditionFunc, options ...WaitOption) error {
+	if condition == nil {
+		glog.V(100).Infof("The condition function is nil")
+
+		return fmt.Errorf("condition function cannot be nil")
+	}
+
+	return PollWithContext(timeout, func(context.Context) (bool, error) {
+		return condition()
+	}, options...)
+}
+
+// PollWithContext behaves like Poll and passes the context of the WithContext option to condition, so that the
+// requests sent by condition are canceled together with the wait. Every wait is reported to the WaitReporter set
+// with SetWaitReporter, if any.
+func PollWithContext(timeout time.Duration, condition wait.ConditionWithContextFunc, options ...WaitOption) error {
+	if condition == nil {
+		glog.V(100).Infof("The condition function is nil")
+
+		return fmt.Errorf("condition function cannot be nil")
+	}
+
+	config := newWaitConfig(options)
+	start := time.Now()
+
+	err := poll(config, timeout, condition)
+	reportWait(config, start, err)
+
+	return err
+}
+
+// poll checks condition until it returns true, returns an error or timeout elapses.
+func poll(config *waitConfig, timeout time.Duration, condition wait.ConditionWithContextFunc) error {
+	deadline := time.Now().Add(timeout)
+	interval := config.interval
+
+	for attempt := 1; ; attempt++ {
+		if err := config.ctx.Err(); err != nil {
+			return err
+		}
+
+		done, err := condition(config.ctx)
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return wait.ErrWaitTimeout
+		}
+
+		if config.logProgress {
+			glog.V(100).Infof("Waiting for %s: attempt %d unsuccessful, %s remaining",
+				config.description, attempt, remaining.Round(time.Second))
+		}
+
+		if err := sleep(config.ctx, minDuration(interval, remaining)); err != nil {
+			return err
+		}
+
+		if config.backoffFactor > 1 {
+			interval = time.Duration(float64(interval) * config.backoffFactor)
+
+			if config.maxInterval > 0 && interval > config.maxInterval {
+				interval = config.maxInterval
+			}
+		}
+	}
+}
+
+// Context returns the context passed with the WithContext option, or a background context if there is none. It is
+// used by waits that send requests outside of their condition, e.g. a delete before waiting for the deletion.
+func Context(options ...WaitOption) context.Context {
+	return newWaitConfig(options).ctx
+}
+
+// newWaitConfig returns the configuration of a wait with the given options applied to the defaults.
+func newWaitConfig(options []WaitOption) *waitConfig {
+	config := &waitConfig{interval: DefaultInterval, ctx: context.Background()}
+
+	for _, option := range options {
+		if option != nil {
+			option(config)
+		}
+	}
+
+	return config
+}
+
+// sleep waits for duration or until ctx is done, in which case the error of ctx is returned.
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// minDuration returns the smaller of the two durations.
+func minDuration(first, second time.Duration) time.Duration {
+	if first < second {
+		return first
+	}
+
+	return second
+}
@@ -0,0 +1,96 @@
+package await
+
+import (
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WaitRecord describes a wait performed by Poll or PollWithContext.
+type WaitRecord struct {
+	// Caller is the function that started the wait, without its module path, e.g.
+	// "pod.(*Builder).WaitUntilInStatus".
+	Caller string
+	// Description is the description passed with WithProgressLogging, if any.
+	Description string
+	Start       time.Time
+	Duration    time.Duration
+	// Err is the error returned by the wait, nil if the condition was met.
+	Err error
+}
+
+// WaitReporter receives the waits performed by Poll and PollWithContext. It must be safe for concurrent use.
+type WaitReporter func(record WaitRecord)
+
+// waitReporterHolder stores the WaitReporter of the package.
+var waitReporterHolder struct {
+	mutex    sync.RWMutex
+	reporter WaitReporter
+}
+
+// closureSuffixRegex matches the suffix the compiler gives to the closures of a function.
+var closureSuffixRegex = regexp.MustCompile(`(\.func\d+)+$`)
+
+// SetWaitReporter sets the reporter receiving every wait performed by Poll and PollWithContext. A nil reporter
+// disables reporting. The reporter is shared by the whole process, clients.Settings.SetStepReporter sets it.
+func SetWaitReporter(reporter WaitReporter) {
+	waitReporterHolder.mutex.Lock()
+	defer waitReporterHolder.mutex.Unlock()
+
+	waitReporterHolder.reporter = reporter
+}
+
+// reportWait sends a WaitRecord for the wait started at start and ending now with err to the wait reporter, if any.
+func reportWait(config *waitConfig, start time.Time, err error) {
+	waitReporterHolder.mutex.RLock()
+	reporter := waitReporterHolder.reporter
+	waitReporterHolder.mutex.RUnlock()
+
+	if reporter == nil {
+		return
+	}
+
+	reporter(WaitRecord{
+		Caller:      waitCaller(),
+		Description: config.description,
+		Start:       start,
+		Duration:    time.Since(start),
+		Err:         err,
+	})
+}
+
+// waitCaller returns the function that called Poll or PollWithContext, without its module path and closure suffix.
+func waitCaller() string {
+	programCounters := make([]uintptr, 16)
+	frames := runtime.CallersFrames(programCounters[:runtime.Callers(2, programCounters)])
+
+	for {
+		frame, more := frames.Next()
+
+		function := frame.Function
+		if index := strings.LastIndex(function, "/"); index != -1 {
+			function = function[index+1:]
+		}
+
+		if function != "" && !isPollFrame(function) {
+			return closureSuffixRegex.ReplaceAllString(function, "")
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+// isPollFrame returns true for the functions of this package implementing the wait itself.
+func isPollFrame(function string) bool {
+	for _, prefix := range []string{"await.Poll", "await.reportWait", "await.waitCaller"} {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,21 +2,51 @@ package bmh
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
-	"k8s.io/apimachinery/pkg/util/wait"
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"fmt"
 
 	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/secret"
+	"github.com/openshift-kni/eco-goinfra/pkg/serializer"
+	"github.com/openshift-kni/eco-goinfra/pkg/txn"
 	"golang.org/x/exp/slices"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	// rebootAnnotationPrefix is the annotation requesting metal3 to reboot the host.
+	rebootAnnotationPrefix = "reboot.metal3.io"
+	// existsCacheTTL is how long Create and Delete reuse the result of the last Exists, so that an Exists check
+	// immediately followed by Create or Delete does not send the same GET twice.
+	existsCacheTTL = time.Second
+)
+
+var (
+	// macAddressRegex matches a MAC address of six colon or dash separated bytes.
+	macAddressRegex = regexp.MustCompile(`^([0-9A-Fa-f]{2}[:-]){5}[0-9A-Fa-f]{2}$`)
+	// bmcSchemes are the BMC drivers accepted in the scheme of the BMC address.
+	bmcSchemes = []string{"redfish", "redfish-virtualmedia", "ipmi", "idrac-virtualmedia"}
+	// hardwareRAIDLevels are the RAID levels accepted by metal3 for hardware RAID volumes.
+	hardwareRAIDLevels = []string{"0", "1", "2", "5", "6", "1+0", "5+0", "6+0"}
+	// softwareRAIDLevels are the RAID levels accepted by metal3 for software RAID volumes.
+	softwareRAIDLevels = []string{"0", "1", "1+0"}
 )
 
 // BmhBuilder provides struct for the bmh object containing connection to
@@ -26,6 +56,16 @@ type BmhBuilder struct {
 	Object     *bmhv1alpha1.BareMetalHost
 	apiClient  *clients.Settings
 	errorMsg   string
+	// credentialsSecret is the BMC secret owned by the builder. It is only set by NewBuilderWithCredentials and
+	// is created before and removed after the bmh.
+	credentialsSecret *secret.Builder
+	// existsCheckedAt is the time of the last Exists lookup and exists its result. A zero time means no result is
+	// cached.
+	existsCheckedAt time.Time
+	exists          bool
+	// inspectionTriggeredAt is the time of the last TriggerInspection call, truncated to the second precision of the
+	// bmh operation history.
+	inspectionTriggeredAt time.Time
 }
 
 // AdditionalOptions additional options for bmh object.
@@ -65,34 +105,90 @@ func NewBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the baremetalhost is empty")
 
-		builder.errorMsg = "BMH 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BMH 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the baremetalhost is empty")
 
-		builder.errorMsg = "BMH 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BMH 'nsname' cannot be empty")
 	}
 
 	if bmcAddress == "" {
 		glog.V(100).Infof("The bootmacaddress of the baremetalhost is empty")
 
-		builder.errorMsg = "BMH 'bmcAddress' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BMH 'bmcAddress' cannot be empty")
 	}
 
 	if bmcSecretName == "" {
 		glog.V(100).Infof("The bmcsecret of the baremetalhost is empty")
 
-		builder.errorMsg = "BMH 'bmcSecretName' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BMH 'bmcSecretName' cannot be empty")
 	}
 
 	bootModeAcceptable := []string{"UEFI", "UEFISecureBoot", "legacy"}
 	if !slices.Contains(bootModeAcceptable, bootMode) {
-		builder.errorMsg = "Not acceptable 'bootMode' value"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Not acceptable 'bootMode' value")
 	}
 
 	if bootMacAddress == "" {
-		builder.errorMsg = "BMH 'bootMacAddress' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BMH 'bootMacAddress' cannot be empty")
+	}
+
+	return &builder
+}
+
+// NewExternallyProvisionedBuilder creates a new instance of BmhBuilder for a host that is already provisioned and
+// running. Metal3 registers and inspects such a host without writing an image to it.
+func NewExternallyProvisionedBuilder(
+	apiClient *clients.Settings,
+	name string,
+	nsname string,
+	bmcAddress string,
+	bmcSecretName string,
+	bootMacAddress string,
+	bootMode string) *BmhBuilder {
+	glog.V(100).Infof("Initializing new externally provisioned baremetalhost %s in namespace %s", name, nsname)
+
+	builder := NewBuilder(apiClient, name, nsname, bmcAddress, bmcSecretName, bootMacAddress, bootMode)
+	builder.Definition.Spec.ExternallyProvisioned = true
+
+	return builder
+}
+
+// NewBuilderFromYAML creates a new instance of BmhBuilder from a BareMetalHost YAML manifest, e.g. a golden
+// manifest loaded from testdata. The resourceVersion and uid of the manifest are dropped so that a dumped live
+// object can be created again.
+func NewBuilderFromYAML(apiClient *clients.Settings, data []byte) *BmhBuilder {
+	glog.V(100).Infof("Initializing new baremetalhost from YAML manifest")
+
+	builder := BmhBuilder{
+		apiClient:  apiClient,
+		Definition: &bmhv1alpha1.BareMetalHost{},
+	}
+
+	err := serializer.FromYAML(data, builder.Definition, bmhv1alpha1.GroupVersion.WithKind("BareMetalHost"))
+	if err != nil {
+		glog.V(100).Infof("Failed to decode the baremetalhost YAML manifest: %s", err.Error())
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
+
+		return &builder
+	}
+
+	builder.Definition.ResourceVersion = ""
+	builder.Definition.UID = ""
+
+	if builder.Definition.Name == "" {
+		glog.V(100).Infof("The name of the baremetalhost is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BMH 'name' cannot be empty")
+	}
+
+	if builder.Definition.Namespace == "" {
+		glog.V(100).Infof("The namespace of the baremetalhost is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BMH 'nsname' cannot be empty")
 	}
 
 	return &builder
@@ -107,7 +203,7 @@ func (builder *BmhBuilder) WithRootDeviceDeviceName(deviceName string) *BmhBuild
 	if deviceName == "" {
 		glog.V(100).Infof("The baremetalhost rootDeviceHint deviceName is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint deviceName cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost rootDeviceHint deviceName cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -132,7 +228,7 @@ func (builder *BmhBuilder) WithRootDeviceHTCL(hctl string) *BmhBuilder {
 	if hctl == "" {
 		glog.V(100).Infof("The baremetalhost rootDeviceHint hctl is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint hctl cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost rootDeviceHint hctl cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -157,7 +253,7 @@ func (builder *BmhBuilder) WithRootDeviceModel(model string) *BmhBuilder {
 	if model == "" {
 		glog.V(100).Infof("The baremetalhost rootDeviceHint model is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint model cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost rootDeviceHint model cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -182,7 +278,7 @@ func (builder *BmhBuilder) WithRootDeviceVendor(vendor string) *BmhBuilder {
 	if vendor == "" {
 		glog.V(100).Infof("The baremetalhost rootDeviceHint vendor is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint vendor cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost rootDeviceHint vendor cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -193,7 +289,7 @@ func (builder *BmhBuilder) WithRootDeviceVendor(vendor string) *BmhBuilder {
 		builder.Definition.Spec.RootDeviceHints = &bmhv1alpha1.RootDeviceHints{}
 	}
 
-	builder.Definition.Spec.RootDeviceHints.Model = vendor
+	builder.Definition.Spec.RootDeviceHints.Vendor = vendor
 
 	return builder
 }
@@ -207,7 +303,8 @@ func (builder *BmhBuilder) WithRootDeviceSerialNumber(serialNumber string) *BmhB
 	if serialNumber == "" {
 		glog.V(100).Infof("The baremetalhost rootDeviceHint serialNumber is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint serialNumber cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "the baremetalhost rootDeviceHint serialNumber cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -232,7 +329,7 @@ func (builder *BmhBuilder) WithRootDeviceMinSizeGigabytes(size int) *BmhBuilder
 	if size < 0 {
 		glog.V(100).Infof("The baremetalhost rootDeviceHint size is less than 0")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint size cannot be less than 0"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost rootDeviceHint size cannot be less than 0")
 	}
 
 	if builder.errorMsg != "" {
@@ -257,7 +354,7 @@ func (builder *BmhBuilder) WithRootDeviceWWN(wwn string) *BmhBuilder {
 	if wwn == "" {
 		glog.V(100).Infof("The baremetalhost rootDeviceHint wwn is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint wwn cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost rootDeviceHint wwn cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -282,7 +379,8 @@ func (builder *BmhBuilder) WithRootDeviceWWNWithExtension(wwnWithExtension strin
 	if wwnWithExtension == "" {
 		glog.V(100).Infof("The baremetalhost rootDeviceHint wwnWithExtension is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint wwnWithExtension cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "the baremetalhost rootDeviceHint wwnWithExtension cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -307,7 +405,8 @@ func (builder *BmhBuilder) WithRootDeviceWWNVendorExtension(wwnVendorExtension s
 	if wwnVendorExtension == "" {
 		glog.V(100).Infof("The baremetalhost rootDeviceHint wwnVendorExtension is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint wwnVendorExtension cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "the baremetalhost rootDeviceHint wwnVendorExtension cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -338,258 +437,1512 @@ func (builder *BmhBuilder) WithRootDeviceRotationalDisk(rotational bool) *BmhBui
 	return builder
 }
 
-// WithOptions creates bmh with generic mutation options.
-func (builder *BmhBuilder) WithOptions(options ...AdditionalOptions) *BmhBuilder {
+// WithRootDeviceHints sets all rootDeviceHints at once, replacing any hint set before.
+func (builder *BmhBuilder) WithRootDeviceHints(hints *bmhv1alpha1.RootDeviceHints) *BmhBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting bmh additional options")
+	if hints == nil {
+		glog.V(100).Infof("The baremetalhost rootDeviceHints are nil")
 
-	for _, option := range options {
-		if option != nil {
-			builder, err := option(builder)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost rootDeviceHints cannot be nil")
 
-			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+		return builder
+	}
 
-				builder.errorMsg = err.Error()
+	if equality.Semantic.DeepEqual(*hints, bmhv1alpha1.RootDeviceHints{}) {
+		glog.V(100).Infof("The baremetalhost rootDeviceHints are empty")
 
-				return builder
-			}
-		}
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost rootDeviceHints cannot be empty")
+	}
+
+	if hints.MinSizeGigabytes < 0 {
+		glog.V(100).Infof("The baremetalhost rootDeviceHint size is less than 0")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost rootDeviceHint size cannot be less than 0")
+	}
+
+	if builder.errorMsg != "" {
+		return builder
 	}
 
+	builder.Definition.Spec.RootDeviceHints = hints.DeepCopy()
+
 	return builder
 }
 
-// Pull pulls existing baremetalhost from cluster.
-func Pull(apiClient *clients.Settings, name, nsname string) (*BmhBuilder, error) {
-	glog.V(100).Infof("Pulling existing baremetalhost name %s under namespace %s from cluster", name, nsname)
+// WithPreprovisioningNetworkDataName sets the name of the secret holding the network configuration passed to the
+// preprovisioning image. It allows to define which interface the host uses while it is managed by metal3 on hosts
+// with multiple NICs, instead of relying on the cluster-wide provisioning network defaults.
+func (builder *BmhBuilder) WithPreprovisioningNetworkDataName(secretName string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
 
-	builder := BmhBuilder{
-		apiClient: apiClient,
-		Definition: &bmhv1alpha1.BareMetalHost{
-			ObjectMeta: metaV1.ObjectMeta{
-				Name:      name,
-				Namespace: nsname,
-			},
-		},
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s preprovisioningNetworkDataName to %s",
+		builder.ObjectName(), builder.ObjectNamespace(), secretName)
+
+	if secretName == "" {
+		glog.V(100).Infof("The baremetalhost preprovisioningNetworkDataName is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "the baremetalhost preprovisioningNetworkDataName cannot be empty")
 	}
 
-	if name == "" {
-		glog.V(100).Infof("The name of the baremetalhost is empty")
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.Definition.Spec.PreprovisioningNetworkDataName = secretName
 
-		builder.errorMsg = "baremetalhost 'name' cannot be empty"
+	return builder
+}
+
+// WithAutomatedCleaningMode sets the automated cleaning mode of the bmh. The metadata mode wipes the disk partition
+// tables before and after provisioning, the disabled mode skips cleaning altogether.
+func (builder *BmhBuilder) WithAutomatedCleaningMode(mode bmhv1alpha1.AutomatedCleaningMode) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
 	}
 
-	if nsname == "" {
-		glog.V(100).Infof("The namespace of the baremetalhost is empty")
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s automatedCleaningMode to %s",
+		builder.ObjectName(), builder.ObjectNamespace(), mode)
+
+	cleaningModeAcceptable := []bmhv1alpha1.AutomatedCleaningMode{
+		bmhv1alpha1.CleaningModeDisabled, bmhv1alpha1.CleaningModeMetadata}
+	if !slices.Contains(cleaningModeAcceptable, mode) {
+		glog.V(100).Infof("The baremetalhost automatedCleaningMode %s is not acceptable", mode)
 
-		builder.errorMsg = "baremetalhost 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("not acceptable 'automatedCleaningMode' value %s", mode))
 	}
 
-	if !builder.Exists() {
-		return nil, fmt.Errorf("baremetalhost object %s doesn't exist in namespace %s", name, nsname)
+	if builder.errorMsg != "" {
+		return builder
 	}
 
-	builder.Definition = builder.Object
+	builder.Definition.Spec.AutomatedCleaningMode = mode
 
-	return &builder, nil
+	return builder
 }
 
-// Create makes a bmh in the cluster and stores the created object in struct.
-func (builder *BmhBuilder) Create() (*BmhBuilder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
+// WithHardwareRAID sets the hardware RAID volumes configured by metal3 while preparing the host. An empty list clears
+// the hardware RAID configuration. It cannot be combined with WithSoftwareRAID.
+func (builder *BmhBuilder) WithHardwareRAID(volumes []bmhv1alpha1.HardwareRAIDVolume) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
 	}
 
-	glog.V(100).Infof("Creating the baremetalhost %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s hardware RAID volumes to %v",
+		builder.ObjectName(), builder.ObjectNamespace(), volumes)
 
-	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
-		if err == nil {
-			builder.Object = builder.Definition
+	if raid := builder.Definition.Spec.RAID; raid != nil && len(raid.SoftwareRAIDVolumes) > 0 {
+		glog.V(100).Infof("The baremetalhost already has software RAID volumes")
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "the baremetalhost cannot have both hardware and software RAID volumes")
+	}
+
+	for _, volume := range volumes {
+		if !slices.Contains(hardwareRAIDLevels, volume.Level) {
+			glog.V(100).Infof("The baremetalhost hardware RAID level %s is not acceptable", volume.Level)
+
+			builder.errorMsg = msg.AppendErrorMsg(
+				builder.errorMsg, fmt.Sprintf("not acceptable hardware RAID 'level' value %q", volume.Level))
 		}
 	}
 
-	return builder, err
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	if volumes == nil {
+		volumes = []bmhv1alpha1.HardwareRAIDVolume{}
+	}
+
+	builder.Definition.Spec.RAID = &bmhv1alpha1.RAIDConfig{HardwareRAIDVolumes: volumes}
+
+	return builder
 }
 
-// Delete removes bmh from a cluster.
-func (builder *BmhBuilder) Delete() (*BmhBuilder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
+// WithSoftwareRAID sets the software RAID volumes configured by metal3 while preparing the host. Metal3 accepts one
+// or two volumes, the first one being a RAID-1. It cannot be combined with WithHardwareRAID.
+func (builder *BmhBuilder) WithSoftwareRAID(volumes []bmhv1alpha1.SoftwareRAIDVolume) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
 	}
 
-	glog.V(100).Infof("Deleting the baremetalhost %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s software RAID volumes to %v",
+		builder.ObjectName(), builder.ObjectNamespace(), volumes)
 
-	if !builder.Exists() {
-		return builder, fmt.Errorf("bmh cannot be deleted because it does not exist")
+	if raid := builder.Definition.Spec.RAID; raid != nil && len(raid.HardwareRAIDVolumes) > 0 {
+		glog.V(100).Infof("The baremetalhost already has hardware RAID volumes")
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "the baremetalhost cannot have both hardware and software RAID volumes")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	if len(volumes) < 1 || len(volumes) > 2 {
+		glog.V(100).Infof("The baremetalhost software RAID volume count %d is not acceptable", len(volumes))
 
-	if err != nil {
-		return builder, fmt.Errorf("can not delete bmh: %w", err)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("the baremetalhost must have 1 or 2 software RAID volumes, got %d", len(volumes)))
+	} else if volumes[0].Level != "1" {
+		glog.V(100).Infof("The baremetalhost first software RAID volume level %s is not 1", volumes[0].Level)
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "the baremetalhost first software RAID volume must be a RAID-1")
 	}
 
-	builder.Object = nil
+	for _, volume := range volumes {
+		if !slices.Contains(softwareRAIDLevels, volume.Level) {
+			glog.V(100).Infof("The baremetalhost software RAID level %s is not acceptable", volume.Level)
 
-	return builder, nil
-}
+			builder.errorMsg = msg.AppendErrorMsg(
+				builder.errorMsg, fmt.Sprintf("not acceptable software RAID 'level' value %q", volume.Level))
+		}
 
-// Exists checks whether the given bmh exists.
-func (builder *BmhBuilder) Exists() bool {
-	if valid, _ := builder.validate(); !valid {
-		return false
+		if len(volume.PhysicalDisks) == 1 {
+			glog.V(100).Infof("The baremetalhost software RAID volume has a single physical disk")
+
+			builder.errorMsg = msg.AppendErrorMsg(
+				builder.errorMsg, "the baremetalhost software RAID volume must have at least 2 physical disks")
+		}
 	}
 
-	glog.V(100).Infof("Checking if baremetalhost %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	if builder.errorMsg != "" {
+		return builder
+	}
 
-	var err error
-	builder.Object, err = builder.Get()
+	builder.Definition.Spec.RAID = &bmhv1alpha1.RAIDConfig{SoftwareRAIDVolumes: volumes}
 
-	return err == nil || !k8serrors.IsNotFound(err)
+	return builder
 }
 
-// Get returns bmh object if found.
-func (builder *BmhBuilder) Get() (*bmhv1alpha1.BareMetalHost, error) {
-	if valid, err := builder.validate(); !valid {
-		return nil, err
+// WithBootMACAddress sets the MAC address of the NIC the bmh boots from over the provisioning network.
+func (builder *BmhBuilder) WithBootMACAddress(mac string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
 	}
 
-	glog.V(100).Infof("Getting baremetalhost %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s bootMACAddress to %s",
+		builder.ObjectName(), builder.ObjectNamespace(), mac)
 
-	bmh := &bmhv1alpha1.BareMetalHost{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
-		Name:      builder.Definition.Name,
-		Namespace: builder.Definition.Namespace,
-	}, bmh)
+	if !macAddressRegex.MatchString(mac) {
+		glog.V(100).Infof("The baremetalhost bootMACAddress %s is not a valid MAC address", mac)
 
-	if err != nil {
-		return nil, err
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("not acceptable 'bootMACAddress' value %q", mac))
 	}
 
-	return bmh, err
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.Definition.Spec.BootMACAddress = mac
+
+	return builder
 }
 
-// CreateAndWaitUntilProvisioned creates bmh object and waits until bmh is provisioned.
-func (builder *BmhBuilder) CreateAndWaitUntilProvisioned(timeout time.Duration) (*BmhBuilder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
+// WithBMCAddress sets the address of the BMC, the name of the secret holding its credentials and whether its
+// server certificate is verified. The address scheme must be one of redfish, redfish-virtualmedia, ipmi or
+// idrac-virtualmedia, optionally followed by a transport, e.g. redfish+https://10.1.1.1/redfish/v1/Systems/1.
+func (builder *BmhBuilder) WithBMCAddress(
+	address, credentialsName string, disableCertVerification bool) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
 	}
 
-	glog.V(100).Infof(`Creating the baremetalhost %s in namespace %s and 
-	waiting for the defined period until it's created`,
-		builder.Definition.Name, builder.Definition.Namespace)
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s BMC address to %s with credentials %s",
+		builder.ObjectName(), builder.ObjectNamespace(), address, credentialsName)
 
-	builder, err := builder.Create()
-	if err != nil {
-		return nil, err
+	if err := validateBMCAddress(address); err != nil {
+		glog.V(100).Infof("The baremetalhost BMC address %s is not acceptable: %s", address, err.Error())
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
+	}
+
+	if credentialsName == "" {
+		glog.V(100).Infof("The bmcsecret of the baremetalhost is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BMH 'bmcSecretName' cannot be empty")
 	}
 
-	err = builder.WaitUntilProvisioned(timeout)
+	if builder.errorMsg != "" {
+		return builder
+	}
 
-	return builder, err
-}
+	builder.Definition.Spec.BMC = bmhv1alpha1.BMCDetails{
+		Address:                        address,
+		CredentialsName:                credentialsName,
+		DisableCertificateVerification: disableCertVerification,
+	}
 
-// WaitUntilProvisioned waits for timeout duration or until bmh is provisioned.
-func (builder *BmhBuilder) WaitUntilProvisioned(timeout time.Duration) error {
-	return builder.WaitUntilInStatus(bmhv1alpha1.StateProvisioned, timeout)
+	return builder
 }
 
-// WaitUntilProvisioning waits for timeout duration or until bmh is provisioning.
-func (builder *BmhBuilder) WaitUntilProvisioning(timeout time.Duration) error {
-	return builder.WaitUntilInStatus(bmhv1alpha1.StateProvisioning, timeout)
+// WithBMCCertificateVerification sets whether the server certificate of the BMC is verified when connecting over
+// HTTPS. Verification is disabled by default, since most lab BMCs use self-signed certificates.
+func (builder *BmhBuilder) WithBMCCertificateVerification(enabled bool) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s BMC certificate verification to %t",
+		builder.ObjectName(), builder.ObjectNamespace(), enabled)
+
+	builder.Definition.Spec.BMC.DisableCertificateVerification = !enabled
+
+	return builder
 }
 
-// WaitUntilReady waits for timeout duration or until bmh is ready.
-func (builder *BmhBuilder) WaitUntilReady(timeout time.Duration) error {
-	return builder.WaitUntilInStatus(bmhv1alpha1.StateReady, timeout)
+// WithNetworkDataSecret sets the secret holding the network configuration applied to the provisioned host.
+func (builder *BmhBuilder) WithNetworkDataSecret(name, nsname string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s networkData to secret %s in namespace %s",
+		builder.ObjectName(), builder.ObjectNamespace(), name, nsname)
+
+	builder.Definition.Spec.NetworkData = builder.secretReference("networkData", name, nsname)
+
+	return builder
 }
 
-// WaitUntilAvailable waits for timeout duration or until bmh is available.
-func (builder *BmhBuilder) WaitUntilAvailable(timeout time.Duration) error {
-	return builder.WaitUntilInStatus(bmhv1alpha1.StateAvailable, timeout)
+// WithUserDataSecret sets the secret holding the user data, e.g. a cloud-init configuration, passed to the
+// provisioned host.
+func (builder *BmhBuilder) WithUserDataSecret(name, nsname string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s userData to secret %s in namespace %s",
+		builder.ObjectName(), builder.ObjectNamespace(), name, nsname)
+
+	builder.Definition.Spec.UserData = builder.secretReference("userData", name, nsname)
+
+	return builder
 }
 
-// WaitUntilInStatus waits for timeout duration or until bmh gets to a specific status.
-func (builder *BmhBuilder) WaitUntilInStatus(status bmhv1alpha1.ProvisioningState, timeout time.Duration) error {
-	if valid, err := builder.validate(); !valid {
-		return err
+// WithMetaDataSecret sets the secret holding the metadata passed to the provisioned host.
+func (builder *BmhBuilder) WithMetaDataSecret(name, nsname string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
 	}
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
-		var err error
-		builder.Object, err = builder.Get()
-		if err != nil {
-			return false, nil
-		}
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s metaData to secret %s in namespace %s",
+		builder.ObjectName(), builder.ObjectNamespace(), name, nsname)
 
-		if builder.Object.Status.Provisioning.State == status {
-			return true, nil
-		}
+	builder.Definition.Spec.MetaData = builder.secretReference("metaData", name, nsname)
 
-		return false, err
-	})
+	return builder
 }
 
-// DeleteAndWaitUntilDeleted delete bmh object and waits until deleted.
-func (builder *BmhBuilder) DeleteAndWaitUntilDeleted(timeout time.Duration) (*BmhBuilder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
+// WithImage sets the image provisioned on the host. The checksum is not required for live-iso images, an empty
+// diskFormat lets metal3 detect the format.
+func (builder *BmhBuilder) WithImage(
+	url, checksum string, checksumType bmhv1alpha1.ChecksumType, diskFormat string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
 	}
 
-	glog.V(100).Infof(`Deleting baremetalhost %s in namespace %s and 
-	waiting for the defined period until it's removed`,
-		builder.Definition.Name, builder.Definition.Namespace)
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s image to %s",
+		builder.ObjectName(), builder.ObjectNamespace(), url)
 
-	builder, err := builder.Delete()
-	if err != nil {
-		return builder, err
+	if url == "" {
+		glog.V(100).Infof("The baremetalhost image url is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost image url cannot be empty")
 	}
 
-	err = builder.WaitUntilDeleted(timeout)
+	diskFormatAcceptable := []string{"", "raw", "qcow2", "vdi", "vmdk", "live-iso"}
+	if !slices.Contains(diskFormatAcceptable, diskFormat) {
+		glog.V(100).Infof("The baremetalhost image diskFormat %s is not supported", diskFormat)
 
-	return nil, err
-}
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Not acceptable 'diskFormat' value")
+	}
 
-// WaitUntilDeleted waits for timeout duration or until bmh is deleted.
-func (builder *BmhBuilder) WaitUntilDeleted(timeout time.Duration) error {
-	if valid, err := builder.validate(); !valid {
-		return err
+	if checksum == "" && diskFormat != "live-iso" {
+		glog.V(100).Infof("The baremetalhost image checksum is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost image checksum cannot be empty")
 	}
 
-	err := wait.Poll(time.Second, timeout, func() (bool, error) {
-		_, err := builder.Get()
-		if err == nil {
-			glog.V(100).Infof("bmh %s/%s still present",
-				builder.Definition.Namespace,
-				builder.Definition.Name)
+	if builder.errorMsg != "" {
+		return builder
+	}
 
-			return false, nil
-		}
-		if k8serrors.IsNotFound(err) {
-			glog.V(100).Infof("bmh %s/%s is gone",
-				builder.Definition.Namespace,
-				builder.Definition.Name)
+	builder.Definition.Spec.Image = &bmhv1alpha1.Image{
+		URL:          url,
+		Checksum:     checksum,
+		ChecksumType: checksumType,
+	}
 
-			return true, nil
-		}
-		glog.V(100).Infof("failed to get bmh %s/%s: %v",
-			builder.Definition.Namespace,
-			builder.Definition.Name, err)
+	if diskFormat != "" {
+		builder.Definition.Spec.Image.DiskFormat = &diskFormat
+	}
+
+	return builder
+}
+
+// WithCustomDeploy sets the custom deploy method run by the deploy ramdisk instead of writing an image, e.g.
+// "install_coreos" for image-based installs.
+func (builder *BmhBuilder) WithCustomDeploy(method string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s customDeploy method to %s",
+		builder.ObjectName(), builder.ObjectNamespace(), method)
+
+	if method == "" {
+		glog.V(100).Infof("The baremetalhost customDeploy method is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost customDeploy method cannot be empty")
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.Definition.Spec.CustomDeploy = &bmhv1alpha1.CustomDeploy{Method: method}
+
+	return builder
+}
+
+// WithDescription sets the human readable description of the bmh, e.g. its location or purpose in a shared lab.
+func (builder *BmhBuilder) WithDescription(description string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s description to %s",
+		builder.ObjectName(), builder.ObjectNamespace(), description)
+
+	if description == "" {
+		glog.V(100).Infof("The baremetalhost description is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost description cannot be empty")
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.Definition.Spec.Description = description
+
+	return builder
+}
+
+// WithHardwareProfile sets the hardware profile of the bmh, which selects the root device and other defaults used
+// by metal3 for this class of hardware.
+func (builder *BmhBuilder) WithHardwareProfile(hardwareProfile string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s hardwareProfile to %s",
+		builder.ObjectName(), builder.ObjectNamespace(), hardwareProfile)
+
+	if hardwareProfile == "" {
+		glog.V(100).Infof("The baremetalhost hardwareProfile is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost hardwareProfile cannot be empty")
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.Definition.Spec.HardwareProfile = hardwareProfile
+
+	return builder
+}
+
+// WithConsumerRef sets the reference to the object consuming the bmh, e.g. the Machine bound to it.
+func (builder *BmhBuilder) WithConsumerRef(objRef v1.ObjectReference) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s consumerRef to %s %s/%s",
+		builder.ObjectName(), builder.ObjectNamespace(), objRef.Kind, objRef.Namespace, objRef.Name)
+
+	if objRef.Name == "" || objRef.Kind == "" {
+		glog.V(100).Infof("The baremetalhost consumerRef name or kind is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost consumerRef name and kind cannot be empty")
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	builder.Definition.Spec.ConsumerRef = &objRef
+
+	return builder
+}
+
+// WithLabel sets the given label on the bmh definition, so that it is present from the first Create.
+func (builder *BmhBuilder) WithLabel(key, value string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Labeling baremetalhost %s in namespace %s with %s=%s",
+		builder.ObjectName(), builder.ObjectNamespace(), key, value)
+
+	if key == "" {
+		glog.V(100).Infof("The baremetalhost label key is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost label key cannot be empty")
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	if builder.Definition.Labels == nil {
+		builder.Definition.Labels = make(map[string]string)
+	}
+
+	builder.Definition.Labels[key] = value
+
+	return builder
+}
+
+// WithLabels sets all the given labels on the bmh definition.
+func (builder *BmhBuilder) WithLabels(labels map[string]string) *BmhBuilder {
+	for key, value := range labels {
+		builder = builder.WithLabel(key, value)
+	}
+
+	return builder
+}
+
+// WithAnnotation sets the given annotation on the bmh definition, so that it is present from the first Create.
+func (builder *BmhBuilder) WithAnnotation(key, value string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Annotating baremetalhost %s in namespace %s with %s=%s",
+		builder.ObjectName(), builder.ObjectNamespace(), key, value)
+
+	if key == "" {
+		glog.V(100).Infof("The baremetalhost annotation key is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost annotation key cannot be empty")
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	if builder.Definition.Annotations == nil {
+		builder.Definition.Annotations = make(map[string]string)
+	}
+
+	builder.Definition.Annotations[key] = value
+
+	return builder
+}
+
+// WithAnnotations sets all the given annotations on the bmh definition.
+func (builder *BmhBuilder) WithAnnotations(annotations map[string]string) *BmhBuilder {
+	for key, value := range annotations {
+		builder = builder.WithAnnotation(key, value)
+	}
+
+	return builder
+}
+
+// WithTTL marks the bmh for cleanup once the given duration has elapsed. The expiry is stored in the
+// TTLAnnotation of the bmh and, for builders created by NewBuilderWithCredentials, copied to the owned BMC secret on
+// creation, so that both can be removed by ReapExpired.
+func (builder *BmhBuilder) WithTTL(ttl time.Duration) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s ttl to %s",
+		builder.ObjectName(), builder.ObjectNamespace(), ttl)
+
+	if ttl <= 0 {
+		glog.V(100).Infof("The baremetalhost ttl is not positive")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the baremetalhost ttl must be greater than 0")
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	if builder.Definition.Annotations == nil {
+		builder.Definition.Annotations = make(map[string]string)
+	}
+
+	builder.Definition.Annotations[TTLAnnotation] = time.Now().Add(ttl).UTC().Format(time.RFC3339)
+
+	return builder
+}
+
+// WithOptions creates bmh with generic mutation options.
+func (builder *BmhBuilder) WithOptions(options ...AdditionalOptions) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting bmh additional options")
+
+	for _, option := range options {
+		if option != nil {
+			mutatedBuilder, err := option(builder)
+
+			if err != nil {
+				glog.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
+
+				return builder
+			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Pull pulls existing baremetalhost from cluster.
+func Pull(apiClient *clients.Settings, name, nsname string) (*BmhBuilder, error) {
+	glog.V(100).Infof("Pulling existing baremetalhost name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient of the baremetalhost is nil")
+
+		return nil, fmt.Errorf("baremetalhost 'apiClient' cannot be nil")
+	}
+
+	builder := BmhBuilder{
+		apiClient: apiClient,
+		Definition: &bmhv1alpha1.BareMetalHost{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the baremetalhost is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "baremetalhost 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the baremetalhost is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "baremetalhost 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("baremetalhost object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Create makes a bmh in the cluster and stores the created object in struct.
+func (builder *BmhBuilder) Create() (*BmhBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext makes a bmh in the cluster and stores the created object in struct. The requests, including the
+// ones for the owned BMC secret, are canceled when ctx is done.
+func (builder *BmhBuilder) CreateWithContext(ctx context.Context) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating the baremetalhost %s in namespace %s",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	transaction := txn.New(fmt.Sprintf("create baremetalhost %s", builder.ObjectName()))
+
+	if err := builder.applyCredentialsSecret(ctx, transaction); err != nil {
+		return builder, err
+	}
+
+	if builder.cachedExists(ctx) {
+		return builder, nil
+	}
+
+	err := builder.apiClient.Create(ctx, builder.Definition)
+	if err != nil {
+		if rollbackErr := transaction.Rollback(); rollbackErr != nil {
+			return builder, utilerrors.NewAggregate([]error{err, rollbackErr})
+		}
+
+		return builder, err
+	}
+
+	transaction.Commit()
+
+	builder.Object = builder.Definition
+	builder.cacheExists(true)
+
+	builder.annotateCredentialsSecretExpiry(ctx)
+
+	return builder, nil
+}
+
+// Delete removes bmh from a cluster.
+func (builder *BmhBuilder) Delete() (*BmhBuilder, error) {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext removes bmh from a cluster. The requests are canceled when ctx is done. The owned BMC secret is
+// kept, since metal3 still needs it to deprovision the host, DeleteAndWaitUntilDeleted removes it once the bmh is gone.
+func (builder *BmhBuilder) DeleteWithContext(ctx context.Context) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Deleting the baremetalhost %s in namespace %s",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	if !builder.cachedExists(ctx) {
+		return builder, fmt.Errorf("bmh cannot be deleted because it does not exist")
+	}
+
+	err := builder.apiClient.Delete(ctx, builder.Definition)
+
+	if err != nil {
+		return builder, fmt.Errorf("can not delete bmh: %w", err)
+	}
+
+	builder.Object = nil
+	builder.Invalidate()
+
+	return builder, nil
+}
+
+// Exists checks whether the given bmh exists and stores the bmh read from the cluster in Object.
+func (builder *BmhBuilder) Exists() bool {
+	return builder.existsWithContext(context.TODO())
+}
+
+// existsWithContext checks whether the given bmh exists and stores it in Object. The get request is canceled when
+// ctx is done.
+func (builder *BmhBuilder) existsWithContext(ctx context.Context) bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if baremetalhost %s exists in namespace %s",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	var err error
+	builder.Object, err = builder.GetWithContext(ctx)
+
+	// Only definite answers are cached, any other error is retried on the next call.
+	if err == nil || k8serrors.IsNotFound(err) {
+		builder.cacheExists(err == nil)
+	}
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// cachedExists returns the result of the last Exists if it is younger than existsCacheTTL and checks whether the bmh
+// exists otherwise. It is only used by Create and Delete, which do not read Object, every other method reads the bmh
+// from the cluster.
+func (builder *BmhBuilder) cachedExists(ctx context.Context) bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	if !builder.existsCheckedAt.IsZero() && time.Since(builder.existsCheckedAt) < existsCacheTTL {
+		glog.V(100).Infof("Using cached existence of baremetalhost %s in namespace %s",
+			builder.ObjectName(), builder.ObjectNamespace())
+
+		return builder.exists
+	}
+
+	return builder.existsWithContext(ctx)
+}
+
+// Invalidate drops the cached result of Exists so that the next Create or Delete reads the bmh from the cluster
+// again. It is only needed when the bmh is changed outside of the builder within a second of the last Exists call.
+func (builder *BmhBuilder) Invalidate() {
+	if builder == nil {
+		return
+	}
+
+	builder.existsCheckedAt = time.Time{}
+}
+
+// Refresh drops the cached result of Exists, reads the bmh from the cluster into Object and returns whether it
+// exists.
+func (builder *BmhBuilder) Refresh() bool {
+	builder.Invalidate()
+
+	return builder.Exists()
+}
+
+// Get returns bmh object if found.
+func (builder *BmhBuilder) Get() (*bmhv1alpha1.BareMetalHost, error) {
+	return builder.GetWithContext(context.TODO())
+}
+
+// GetWithContext returns bmh object if found. The get request is canceled when ctx is done.
+func (builder *BmhBuilder) GetWithContext(ctx context.Context) (*bmhv1alpha1.BareMetalHost, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting baremetalhost %s in namespace %s",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	bmh := &bmhv1alpha1.BareMetalHost{}
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
+		Name:      builder.ObjectName(),
+		Namespace: builder.ObjectNamespace(),
+	}, bmh)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bmh, err
+}
+
+// Age returns the time elapsed since the bmh was created.
+func (builder *BmhBuilder) Age() (time.Duration, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	if !builder.Exists() || builder.Object == nil {
+		return 0, fmt.Errorf("bmh %s in namespace %s does not exist", builder.ObjectName(), builder.ObjectNamespace())
+	}
+
+	return time.Since(builder.Object.CreationTimestamp.Time), nil
+}
+
+// CreateAndWaitUntilProvisioned creates bmh object and waits until bmh is provisioned.
+func (builder *BmhBuilder) CreateAndWaitUntilProvisioned(
+	timeout time.Duration, options ...await.WaitOption) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof(`Creating the baremetalhost %s in namespace %s and 
+	waiting for the defined period until it's created`,
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	builder, err := builder.CreateWithContext(await.Context(options...))
+	if err != nil {
+		return builder, err
+	}
+
+	err = builder.WaitUntilProvisioned(timeout, options...)
+
+	return builder, err
+}
+
+// WaitUntilProvisioned waits for timeout duration or until bmh is provisioned.
+func (builder *BmhBuilder) WaitUntilProvisioned(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.WaitUntilInStatus(bmhv1alpha1.StateProvisioned, timeout, options...)
+}
+
+// WaitUntilProvisioning waits for timeout duration or until bmh is provisioning.
+func (builder *BmhBuilder) WaitUntilProvisioning(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.WaitUntilInStatus(bmhv1alpha1.StateProvisioning, timeout, options...)
+}
+
+// WaitUntilReady waits for timeout duration or until bmh is ready.
+func (builder *BmhBuilder) WaitUntilReady(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.WaitUntilInStatus(bmhv1alpha1.StateReady, timeout, options...)
+}
+
+// WaitUntilAvailable waits for timeout duration or until bmh is available.
+func (builder *BmhBuilder) WaitUntilAvailable(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.WaitUntilInStatus(bmhv1alpha1.StateAvailable, timeout, options...)
+}
+
+// WaitUntilPreparing waits for timeout duration or until bmh is preparing, e.g. applying its RAID configuration.
+func (builder *BmhBuilder) WaitUntilPreparing(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.WaitUntilInStatus(bmhv1alpha1.StatePreparing, timeout, options...)
+}
+
+// WaitUntilDeprovisioning waits for timeout duration or until bmh is deprovisioning.
+func (builder *BmhBuilder) WaitUntilDeprovisioning(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.WaitUntilInStatus(bmhv1alpha1.StateDeprovisioning, timeout, options...)
+}
+
+// WaitUntilDeleting waits for timeout duration or until bmh is deleting.
+func (builder *BmhBuilder) WaitUntilDeleting(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.WaitUntilInStatus(bmhv1alpha1.StateDeleting, timeout, options...)
+}
+
+// WaitUntilInStatus waits for timeout duration or until bmh gets to a specific status.
+func (builder *BmhBuilder) WaitUntilInStatus(
+	status bmhv1alpha1.ProvisioningState, timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	var lastObserved string
+
+	err := await.PollWithContext(timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		builder.Object, err = builder.GetWithContext(ctx)
+		if err != nil {
+			return false, nil
+		}
+
+		lastObserved = fmt.Sprintf("provisioning state %q", builder.Object.Status.Provisioning.State)
+
+		if builder.Object.Status.Provisioning.State == status {
+			return true, nil
+		}
+
+		return false, err
+	}, options...)
+
+	return builder.withTimeoutDetails(err, fmt.Sprintf("provisioning state %q", status), lastObserved)
+}
+
+// WaitUntilNotInStatus waits for timeout duration or until bmh leaves the given provisioning state. A bmh that is
+// not found is not considered to have left the state.
+func (builder *BmhBuilder) WaitUntilNotInStatus(
+	status bmhv1alpha1.ProvisioningState, timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	var lastObserved string
+
+	err := await.PollWithContext(timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		builder.Object, err = builder.GetWithContext(ctx)
+		if err != nil {
+			return false, nil
+		}
+
+		lastObserved = fmt.Sprintf("provisioning state %q", builder.Object.Status.Provisioning.State)
+
+		return builder.Object.Status.Provisioning.State != status, nil
+	}, options...)
+
+	return builder.withTimeoutDetails(err, fmt.Sprintf("provisioning state other than %q", status), lastObserved)
+}
+
+// SetOnline patches the bmh so that metal3 powers the host on.
+func (builder *BmhBuilder) SetOnline() (*BmhBuilder, error) {
+	return builder.SetOnlineWithContext(context.TODO())
+}
+
+// SetOnlineWithContext behaves like SetOnline and cancels its requests when ctx is done.
+func (builder *BmhBuilder) SetOnlineWithContext(ctx context.Context) (*BmhBuilder, error) {
+	return builder.setOnline(ctx, true)
+}
+
+// SetOffline patches the bmh so that metal3 powers the host off.
+func (builder *BmhBuilder) SetOffline() (*BmhBuilder, error) {
+	return builder.SetOfflineWithContext(context.TODO())
+}
+
+// SetOfflineWithContext behaves like SetOffline and cancels its requests when ctx is done.
+func (builder *BmhBuilder) SetOfflineWithContext(ctx context.Context) (*BmhBuilder, error) {
+	return builder.setOnline(ctx, false)
+}
+
+// WaitUntilPoweredOn waits for timeout duration or until bmh reports the host as powered on.
+func (builder *BmhBuilder) WaitUntilPoweredOn(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.waitUntilPoweredOn(true, timeout, options...)
+}
+
+// WaitUntilPoweredOff waits for timeout duration or until bmh reports the host as powered off.
+func (builder *BmhBuilder) WaitUntilPoweredOff(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.waitUntilPoweredOn(false, timeout, options...)
+}
+
+// Reboot requests a reboot of the host by setting the reboot annotation on the bmh. A non-empty key is appended
+// to the annotation so that several clients can request reboots independently.
+func (builder *BmhBuilder) Reboot(mode bmhv1alpha1.RebootMode, key string) (*BmhBuilder, error) {
+	return builder.RebootWithContext(context.TODO(), mode, key)
+}
+
+// RebootWithContext behaves like Reboot and cancels its requests when ctx is done.
+func (builder *BmhBuilder) RebootWithContext(
+	ctx context.Context, mode bmhv1alpha1.RebootMode, key string) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Requesting %s reboot of baremetalhost %s in namespace %s",
+		mode, builder.ObjectName(), builder.ObjectNamespace())
+
+	if mode != bmhv1alpha1.RebootModeHard && mode != bmhv1alpha1.RebootModeSoft {
+		glog.V(100).Infof("The baremetalhost reboot mode %s is not supported", mode)
+
+		return builder, fmt.Errorf("not acceptable reboot mode %q", mode)
+	}
+
+	rebootArgs, err := json.Marshal(bmhv1alpha1.RebootAnnotationArguments{Mode: mode})
+	if err != nil {
+		return builder, err
+	}
+
+	err = builder.patch(ctx, func(bmh *bmhv1alpha1.BareMetalHost) {
+		if bmh.Annotations == nil {
+			bmh.Annotations = make(map[string]string)
+		}
+
+		bmh.Annotations[rebootAnnotation(key)] = string(rebootArgs)
+	})
+	if err != nil {
+		return builder, fmt.Errorf("failed to request bmh reboot: %w", err)
+	}
+
+	return builder, nil
+}
+
+// WaitUntilRebooted waits for timeout duration or until metal3 cleared the reboot annotation with the given key
+// and the host is powered on again.
+func (builder *BmhBuilder) WaitUntilRebooted(key string, timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for baremetalhost %s in namespace %s to be rebooted",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	var lastObserved string
+
+	err := await.PollWithContext(timeout, func(ctx context.Context) (bool, error) {
+		bmh, err := builder.GetWithContext(ctx)
+		if err != nil {
+			return false, nil
+		}
+
+		builder.Object = bmh
+		_, pending := bmh.Annotations[rebootAnnotation(key)]
+		lastObserved = fmt.Sprintf("reboot pending %t, poweredOn %t", pending, bmh.Status.PoweredOn)
+
+		return !pending && bmh.Status.PoweredOn, nil
+	}, options...)
+
+	return builder.withTimeoutDetails(err, "reboot completed and poweredOn true", lastObserved)
+}
+
+// Detach sets the detached annotation on the bmh so that metal3 stops managing the host through Ironic
+// without deprovisioning it.
+func (builder *BmhBuilder) Detach() (*BmhBuilder, error) {
+	return builder.DetachWithContext(context.TODO())
+}
+
+// DetachWithContext behaves like Detach and cancels its requests when ctx is done.
+func (builder *BmhBuilder) DetachWithContext(ctx context.Context) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Detaching baremetalhost %s in namespace %s", builder.ObjectName(), builder.ObjectNamespace())
+
+	err := builder.patch(ctx, func(bmh *bmhv1alpha1.BareMetalHost) {
+		if bmh.Annotations == nil {
+			bmh.Annotations = make(map[string]string)
+		}
+
+		bmh.Annotations[bmhv1alpha1.DetachedAnnotation] = ""
+	})
+	if err != nil {
+		return builder, fmt.Errorf("failed to detach bmh: %w", err)
+	}
+
+	return builder, nil
+}
+
+// Attach removes the detached annotation from the bmh so that metal3 manages the host again.
+func (builder *BmhBuilder) Attach() (*BmhBuilder, error) {
+	return builder.AttachWithContext(context.TODO())
+}
+
+// AttachWithContext behaves like Attach and cancels its requests when ctx is done.
+func (builder *BmhBuilder) AttachWithContext(ctx context.Context) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Attaching baremetalhost %s in namespace %s", builder.ObjectName(), builder.ObjectNamespace())
+
+	err := builder.patch(ctx, func(bmh *bmhv1alpha1.BareMetalHost) {
+		delete(bmh.Annotations, bmhv1alpha1.DetachedAnnotation)
+	})
+	if err != nil {
+		return builder, fmt.Errorf("failed to attach bmh: %w", err)
+	}
+
+	return builder, nil
+}
+
+// WaitUntilDetached waits for timeout duration or until bmh reports the detached operational status.
+func (builder *BmhBuilder) WaitUntilDetached(timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for baremetalhost %s in namespace %s to be detached",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	var lastObserved string
+
+	err := await.PollWithContext(timeout, func(ctx context.Context) (bool, error) {
+		bmh, err := builder.GetWithContext(ctx)
+		if err != nil {
+			return false, nil
+		}
+
+		builder.Object = bmh
+		lastObserved = fmt.Sprintf("operational status %q", bmh.Status.OperationalStatus)
+
+		return bmh.Status.OperationalStatus == bmhv1alpha1.OperationalStatusDetached, nil
+	}, options...)
+
+	return builder.withTimeoutDetails(
+		err, fmt.Sprintf("operational status %q", bmhv1alpha1.OperationalStatusDetached), lastObserved)
+}
+
+// Pause adds the paused annotation to the bmh so that metal3 stops reconciling the host, e.g. while its BMC secret
+// is changed.
+func (builder *BmhBuilder) Pause() (*BmhBuilder, error) {
+	return builder.PauseWithContext(context.TODO())
+}
+
+// PauseWithContext behaves like Pause and cancels its requests when ctx is done.
+func (builder *BmhBuilder) PauseWithContext(ctx context.Context) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Pausing baremetalhost %s in namespace %s", builder.ObjectName(), builder.ObjectNamespace())
+
+	err := builder.patch(ctx, func(bmh *bmhv1alpha1.BareMetalHost) {
+		if bmh.Annotations == nil {
+			bmh.Annotations = make(map[string]string)
+		}
+
+		bmh.Annotations[bmhv1alpha1.PausedAnnotation] = ""
+	})
+	if err != nil {
+		return builder, fmt.Errorf("failed to pause bmh: %w", err)
+	}
+
+	return builder, nil
+}
+
+// Unpause removes the paused annotation from the bmh so that metal3 reconciles the host again.
+func (builder *BmhBuilder) Unpause() (*BmhBuilder, error) {
+	return builder.UnpauseWithContext(context.TODO())
+}
+
+// UnpauseWithContext behaves like Unpause and cancels its requests when ctx is done.
+func (builder *BmhBuilder) UnpauseWithContext(ctx context.Context) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Unpausing baremetalhost %s in namespace %s", builder.ObjectName(), builder.ObjectNamespace())
+
+	err := builder.patch(ctx, func(bmh *bmhv1alpha1.BareMetalHost) {
+		delete(bmh.Annotations, bmhv1alpha1.PausedAnnotation)
+	})
+	if err != nil {
+		return builder, fmt.Errorf("failed to unpause bmh: %w", err)
+	}
+
+	return builder, nil
+}
+
+// IsPaused checks whether the bmh has the paused annotation.
+func (builder *BmhBuilder) IsPaused() bool {
+	if !builder.Exists() || builder.Object == nil {
+		return false
+	}
+
+	_, paused := builder.Object.Annotations[bmhv1alpha1.PausedAnnotation]
+
+	return paused
+}
+
+// WaitUntilPaused waits for timeout duration or until the bmh has the paused annotation and metal3 stopped
+// updating it. Metal3 does not report the paused state, so the bmh is considered paused once its resource version
+// did not change between two consecutive polls.
+func (builder *BmhBuilder) WaitUntilPaused(timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for baremetalhost %s in namespace %s to be paused",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	var lastObserved, lastResourceVersion string
+
+	err := await.PollWithContext(timeout, func(ctx context.Context) (bool, error) {
+		bmh, err := builder.GetWithContext(ctx)
+		if err != nil {
+			return false, nil
+		}
+
+		builder.Object = bmh
+		_, paused := bmh.Annotations[bmhv1alpha1.PausedAnnotation]
+		lastObserved = fmt.Sprintf("paused annotation present %t, resource version %s", paused, bmh.ResourceVersion)
+
+		if !paused {
+			lastResourceVersion = ""
+
+			return false, nil
+		}
+
+		settled := bmh.ResourceVersion == lastResourceVersion
+		lastResourceVersion = bmh.ResourceVersion
+
+		return settled, nil
+	}, options...)
+
+	return builder.withTimeoutDetails(err, "paused annotation present and no further updates", lastObserved)
+}
+
+// UpdateWhilePaused pauses the bmh, patches it with the changes applied by mutate and unpauses it, so that metal3
+// only reconciles the complete change. A bmh that was already paused is left paused. The bmh is unpaused even if the
+// patch failed.
+func (builder *BmhBuilder) UpdateWhilePaused(mutate func(bmh *bmhv1alpha1.BareMetalHost)) (*BmhBuilder, error) {
+	return builder.UpdateWhilePausedWithContext(context.TODO(), mutate)
+}
+
+// UpdateWhilePausedWithContext behaves like UpdateWhilePaused and cancels its requests when ctx is done.
+func (builder *BmhBuilder) UpdateWhilePausedWithContext(
+	ctx context.Context, mutate func(bmh *bmhv1alpha1.BareMetalHost)) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating baremetalhost %s in namespace %s while paused",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	if mutate == nil {
+		glog.V(100).Infof("The baremetalhost mutation function is nil")
+
+		return builder, fmt.Errorf("bmh mutation function cannot be nil")
+	}
+
+	alreadyPaused := builder.IsPaused()
+
+	if !alreadyPaused {
+		if _, err := builder.PauseWithContext(ctx); err != nil {
+			return builder, err
+		}
+	}
+
+	var errs []error
+
+	if err := builder.patch(ctx, mutate); err != nil {
+		errs = append(errs, fmt.Errorf("failed to update paused bmh: %w", err))
+	}
+
+	if !alreadyPaused {
+		if _, err := builder.UnpauseWithContext(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return builder, utilerrors.NewAggregate(errs)
+}
+
+// Deprovision removes the image from the bmh so that metal3 deprovisions the host.
+func (builder *BmhBuilder) Deprovision() (*BmhBuilder, error) {
+	return builder.DeprovisionWithContext(context.TODO())
+}
+
+// DeprovisionWithContext behaves like Deprovision and cancels its requests when ctx is done.
+func (builder *BmhBuilder) DeprovisionWithContext(ctx context.Context) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Deprovisioning baremetalhost %s in namespace %s",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	err := builder.patch(ctx, func(bmh *bmhv1alpha1.BareMetalHost) {
+		bmh.Spec.Image = nil
+	})
+	if err != nil {
+		return builder, fmt.Errorf("failed to deprovision bmh: %w", err)
+	}
+
+	return builder, nil
+}
+
+// WaitUntilDeprovisioned waits for timeout duration or until bmh is deprovisioned and available again.
+func (builder *BmhBuilder) WaitUntilDeprovisioned(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.WaitUntilInStatus(bmhv1alpha1.StateAvailable, timeout, options...)
+}
+
+// DeleteAndWaitUntilDeleted delete bmh object and waits until deleted.
+func (builder *BmhBuilder) DeleteAndWaitUntilDeleted(
+	timeout time.Duration, options ...await.WaitOption) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof(`Deleting baremetalhost %s in namespace %s and 
+	waiting for the defined period until it's removed`,
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	ctx := await.Context(options...)
+
+	builder, err := builder.DeleteWithContext(ctx)
+	if err != nil {
+		return builder, err
+	}
+
+	err = builder.WaitUntilDeleted(timeout, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, builder.deleteCredentialsSecret(ctx)
+}
+
+// WaitUntilDeleted waits for timeout duration or until bmh is deleted.
+func (builder *BmhBuilder) WaitUntilDeleted(timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	var lastObserved string
+
+	err := await.PollWithContext(timeout, func(ctx context.Context) (bool, error) {
+		_, err := builder.GetWithContext(ctx)
+		if err == nil {
+			lastObserved = "object still present"
+
+			glog.V(100).Infof("bmh %s/%s still present",
+				builder.ObjectNamespace(),
+				builder.ObjectName())
+
+			return false, nil
+		}
+		if k8serrors.IsNotFound(err) {
+			glog.V(100).Infof("bmh %s/%s is gone",
+				builder.ObjectNamespace(),
+				builder.ObjectName())
+
+			return true, nil
+		}
+		glog.V(100).Infof("failed to get bmh %s/%s: %v",
+			builder.ObjectNamespace(),
+			builder.ObjectName(), err)
 
 		return false, err
+	}, options...)
+
+	return builder.withTimeoutDetails(err, "deletion", lastObserved)
+}
+
+// setOnline patches the online field of the bmh spec on the cluster.
+func (builder *BmhBuilder) setOnline(ctx context.Context, online bool) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Setting baremetalhost %s in namespace %s online to %t",
+		builder.ObjectName(), builder.ObjectNamespace(), online)
+
+	err := builder.patch(ctx, func(bmh *bmhv1alpha1.BareMetalHost) {
+		bmh.Spec.Online = online
+	})
+	if err != nil {
+		return builder, fmt.Errorf("failed to set bmh online to %t: %w", online, err)
+	}
+
+	return builder, nil
+}
+
+// secretReference returns a reference to the given secret, or nil with errorMsg set if name is empty. An empty
+// nsname references the secret in the bmh namespace.
+func (builder *BmhBuilder) secretReference(field, name, nsname string) *v1.SecretReference {
+	if name == "" {
+		glog.V(100).Infof("The baremetalhost %s secret name is empty", field)
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("the baremetalhost %s secret name cannot be empty", field))
+
+		return nil
+	}
+
+	if nsname == "" {
+		nsname = builder.ObjectNamespace()
+	}
+
+	return &v1.SecretReference{Name: name, Namespace: nsname}
+}
+
+// patch applies mutate to the current bmh object and sends the difference to the cluster as a merge patch. The
+// requests are canceled when ctx is done. On success both the builder object and definition hold the patched bmh.
+func (builder *BmhBuilder) patch(ctx context.Context, mutate func(bmh *bmhv1alpha1.BareMetalHost)) error {
+	if !builder.existsWithContext(ctx) || builder.Object == nil {
+		return fmt.Errorf("bmh %s in namespace %s does not exist", builder.ObjectName(), builder.ObjectNamespace())
+	}
+
+	original := builder.Object.DeepCopy()
+	mutate(builder.Object)
+
+	err := builder.apiClient.Patch(ctx, builder.Object, goclient.MergeFrom(original))
+	if err != nil {
+		return err
+	}
+
+	builder.Definition = builder.Object
+	builder.cacheExists(true)
+
+	return nil
+}
+
+// cacheExists records the existence of the bmh, reused by Create and Delete for the next existsCacheTTL.
+func (builder *BmhBuilder) cacheExists(exists bool) {
+	builder.exists = exists
+	builder.existsCheckedAt = time.Now()
+}
+
+// waitUntilPoweredOn waits for timeout duration or until the bmh power state matches poweredOn.
+func (builder *BmhBuilder) waitUntilPoweredOn(
+	poweredOn bool, timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for baremetalhost %s in namespace %s to report poweredOn %t",
+		builder.ObjectName(), builder.ObjectNamespace(), poweredOn)
+
+	var lastObserved string
+
+	err := await.PollWithContext(timeout, func(ctx context.Context) (bool, error) {
+		bmh, err := builder.GetWithContext(ctx)
+		if err != nil {
+			return false, nil
+		}
+
+		builder.Object = bmh
+		lastObserved = fmt.Sprintf("poweredOn %t", bmh.Status.PoweredOn)
+
+		return bmh.Status.PoweredOn == poweredOn, nil
+	}, options...)
+
+	return builder.withTimeoutDetails(err, fmt.Sprintf("poweredOn %t", poweredOn), lastObserved)
+}
+
+// validateBMCAddress checks that address is a URL with a host and one of the supported BMC schemes.
+func validateBMCAddress(address string) error {
+	bmcURL, err := url.Parse(address)
+	if err != nil {
+		return fmt.Errorf("BMH 'bmcAddress' %q is not a valid URL: %w", address, err)
+	}
+
+	driver, _, _ := strings.Cut(bmcURL.Scheme, "+")
+	if !slices.Contains(bmcSchemes, driver) {
+		return fmt.Errorf("not acceptable 'bmcAddress' scheme %q, supported schemes are %v", bmcURL.Scheme, bmcSchemes)
+	}
+
+	if bmcURL.Host == "" {
+		return fmt.Errorf("BMH 'bmcAddress' %q has no host", address)
+	}
+
+	return nil
+}
+
+// rebootAnnotation returns the reboot annotation for the given key.
+func rebootAnnotation(key string) string {
+	if key == "" {
+		return rebootAnnotationPrefix
+	}
+
+	return fmt.Sprintf("%s/%s", rebootAnnotationPrefix, key)
+}
+
+// withTimeoutDetails converts a wait timeout into an await.WaitTimeoutError referencing the bmh.
+func (builder *BmhBuilder) withTimeoutDetails(err error, wanted, lastObserved string) error {
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          bmhv1alpha1.GroupVersion.WithKind("BareMetalHost"),
+		Name:         builder.ObjectName(),
+		Namespace:    builder.ObjectNamespace(),
+		Wanted:       wanted,
+		LastObserved: lastObserved,
 	})
+}
+
+// ToYAML returns the bmh object currently in the cluster as a YAML manifest, without its managed fields.
+func (builder *BmhBuilder) ToYAML() ([]byte, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Dumping baremetalhost %s in namespace %s as YAML",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("bmh %s in namespace %s does not exist", builder.ObjectName(), builder.ObjectNamespace())
+	}
+
+	return serializer.ToYAML(builder.Object, bmhv1alpha1.GroupVersion.WithKind("BareMetalHost"))
+}
+
+// GetDefinition returns the bmh definition held by the builder. It returns nil if the builder is nil.
+func (builder *BmhBuilder) GetDefinition() *bmhv1alpha1.BareMetalHost {
+	if builder == nil {
+		return nil
+	}
+
+	return builder.Definition
+}
+
+// GetObject returns the last bmh object retrieved from the cluster. It returns nil if the builder is nil or
+// if the object was not retrieved.
+func (builder *BmhBuilder) GetObject() *bmhv1alpha1.BareMetalHost {
+	if builder == nil {
+		return nil
+	}
+
+	return builder.Object
+}
+
+// ObjectName returns the name of the bmh defined in the builder. It returns an empty string if the builder
+// or its definition is nil.
+func (builder *BmhBuilder) ObjectName() string {
+	if builder == nil || builder.Definition == nil {
+		return ""
+	}
+
+	return builder.Definition.Name
+}
+
+// ObjectNamespace returns the namespace of the bmh defined in the builder. It returns an empty string if the
+// builder or its definition is nil.
+func (builder *BmhBuilder) ObjectNamespace() string {
+	if builder == nil || builder.Definition == nil {
+		return ""
+	}
 
-	return err
+	return builder.Definition.Namespace
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -606,19 +1959,20 @@ func (builder *BmhBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
 		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, errors.New(builder.errorMsg)
 	}
 
 	return true, nil
@@ -0,0 +1,50 @@
+package bmh
+
+import (
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+)
+
+// Provisioning states of a bmh, as passed to WaitUntilInStatus and WaitUntilNotInStatus.
+const (
+	StateNone                  = bmhv1alpha1.StateNone
+	StateUnmanaged             = bmhv1alpha1.StateUnmanaged
+	StateRegistering           = bmhv1alpha1.StateRegistering
+	StateMatchProfile          = bmhv1alpha1.StateMatchProfile
+	StatePreparing             = bmhv1alpha1.StatePreparing
+	StateReady                 = bmhv1alpha1.StateReady
+	StateAvailable             = bmhv1alpha1.StateAvailable
+	StateProvisioning          = bmhv1alpha1.StateProvisioning
+	StateProvisioned           = bmhv1alpha1.StateProvisioned
+	StateExternallyProvisioned = bmhv1alpha1.StateExternallyProvisioned
+	StateDeprovisioning        = bmhv1alpha1.StateDeprovisioning
+	StateInspecting            = bmhv1alpha1.StateInspecting
+	StateDeleting              = bmhv1alpha1.StateDeleting
+)
+
+// Operational statuses of a bmh.
+const (
+	OperationalStatusOK         = bmhv1alpha1.OperationalStatusOK
+	OperationalStatusDiscovered = bmhv1alpha1.OperationalStatusDiscovered
+	OperationalStatusError      = bmhv1alpha1.OperationalStatusError
+	OperationalStatusDelayed    = bmhv1alpha1.OperationalStatus(bmhv1alpha1.OperationalStatusDelayed)
+	OperationalStatusDetached   = bmhv1alpha1.OperationalStatusDetached
+)
+
+// Error types reported by a bmh in error state, as returned by GetErrorType.
+const (
+	ErrorTypeProvisionedRegistration = bmhv1alpha1.ProvisionedRegistrationError
+	ErrorTypeRegistration            = bmhv1alpha1.RegistrationError
+	ErrorTypeInspection              = bmhv1alpha1.InspectionError
+	ErrorTypePreparation             = bmhv1alpha1.PreparationError
+	ErrorTypeProvisioning            = bmhv1alpha1.ProvisioningError
+	ErrorTypePowerManagement         = bmhv1alpha1.PowerManagementError
+	ErrorTypeDetach                  = bmhv1alpha1.DetachError
+)
+
+// Condition types of the hostfirmwaresettings and preprovisioningimage objects.
+const (
+	FirmwareSettingsChangeDetected = bmhv1alpha1.FirmwareSettingsChangeDetected
+	FirmwareSettingsValid          = bmhv1alpha1.FirmwareSettingsValid
+	ImageConditionReady            = bmhv1alpha1.ConditionImageReady
+	ImageConditionError            = bmhv1alpha1.ConditionImageError
+)
@@ -0,0 +1,155 @@
+package bmh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/secret"
+	"github.com/openshift-kni/eco-goinfra/pkg/txn"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// credentialsSecretSuffix is appended to the bmh name to build the name of the generated BMC secret.
+	credentialsSecretSuffix = "-bmc-secret"
+)
+
+// NewBuilderWithCredentials creates a new instance of BmhBuilder together with the BMC secret holding the given
+// credentials. The secret is created or updated right before the bmh on Create, and reverted if the bmh cannot be
+// created. It is removed by DeleteAndWaitUntilDeleted once the bmh is gone.
+func NewBuilderWithCredentials(
+	apiClient *clients.Settings,
+	name string,
+	nsname string,
+	bmcAddress string,
+	bmcUsername string,
+	bmcPassword string,
+	bootMacAddress string,
+	bootMode string) (*BmhBuilder, *secret.Builder) {
+	glog.V(100).Infof("Initializing new baremetalhost %s in namespace %s with generated BMC secret", name, nsname)
+
+	secretName := name + credentialsSecretSuffix
+	credentialsSecret := secret.NewBuilder(apiClient, secretName, nsname, v1.SecretTypeOpaque).
+		WithData(map[string][]byte{
+			"username": []byte(bmcUsername),
+			"password": []byte(bmcPassword),
+		})
+
+	builder := NewBuilder(apiClient, name, nsname, bmcAddress, secretName, bootMacAddress, bootMode)
+	builder.credentialsSecret = credentialsSecret
+
+	if bmcUsername == "" {
+		glog.V(100).Infof("The BMC username of the baremetalhost is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BMH 'bmcUsername' cannot be empty")
+	}
+
+	if bmcPassword == "" {
+		glog.V(100).Infof("The BMC password of the baremetalhost is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BMH 'bmcPassword' cannot be empty")
+	}
+
+	return builder, credentialsSecret
+}
+
+// GetCredentialsSecret returns the BMC secret builder owned by the bmh builder. It returns nil if the bmh builder
+// was not created by NewBuilderWithCredentials.
+func (builder *BmhBuilder) GetCredentialsSecret() *secret.Builder {
+	if builder == nil {
+		return nil
+	}
+
+	return builder.credentialsSecret
+}
+
+// applyCredentialsSecret creates the owned BMC secret, or updates its data if it already exists, and records the
+// change in transaction so that it can be reverted if the bmh cannot be created. The requests are canceled when ctx
+// is done, the undo requests are not so that a canceled create is still cleaned up.
+func (builder *BmhBuilder) applyCredentialsSecret(ctx context.Context, transaction *txn.Transaction) error {
+	if builder.credentialsSecret == nil {
+		return nil
+	}
+
+	secretDefinition := builder.credentialsSecret.Definition
+	existingSecret := &v1.Secret{}
+
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
+		Name:      secretDefinition.Name,
+		Namespace: secretDefinition.Namespace,
+	}, existingSecret)
+
+	if k8serrors.IsNotFound(err) {
+		glog.V(100).Infof("Creating BMC secret %s in namespace %s", secretDefinition.Name, secretDefinition.Namespace)
+
+		createdSecret := secretDefinition.DeepCopy()
+
+		err = transaction.Do(fmt.Sprintf("create bmh credentials secret %s", secretDefinition.Name),
+			func() error {
+				return builder.apiClient.Create(ctx, createdSecret)
+			},
+			func() error {
+				return builder.deleteCredentialsSecret(context.TODO())
+			})
+		if err != nil {
+			return fmt.Errorf("failed to create bmh credentials secret: %w", err)
+		}
+
+		builder.credentialsSecret.Object = createdSecret
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get bmh credentials secret %s: %w", secretDefinition.Name, err)
+	}
+
+	glog.V(100).Infof("Updating existing BMC secret %s in namespace %s",
+		secretDefinition.Name, secretDefinition.Namespace)
+
+	previousData := existingSecret.Data
+
+	err = transaction.Do(fmt.Sprintf("update bmh credentials secret %s", secretDefinition.Name),
+		func() error {
+			existingSecret.Data = secretDefinition.Data
+
+			return builder.apiClient.Update(ctx, existingSecret)
+		},
+		func() error {
+			existingSecret.Data = previousData
+
+			return builder.apiClient.Update(context.TODO(), existingSecret)
+		})
+	if err != nil {
+		return fmt.Errorf("failed to update bmh credentials secret: %w", err)
+	}
+
+	builder.credentialsSecret.Object = existingSecret
+
+	return nil
+}
+
+// deleteCredentialsSecret removes the owned BMC secret. A secret that is already gone is not an error. The request is
+// canceled when ctx is done.
+func (builder *BmhBuilder) deleteCredentialsSecret(ctx context.Context) error {
+	if builder.credentialsSecret == nil {
+		return nil
+	}
+
+	glog.V(100).Infof("Deleting BMC secret %s in namespace %s",
+		builder.credentialsSecret.Definition.Name, builder.credentialsSecret.Definition.Namespace)
+
+	err := builder.apiClient.Delete(ctx, builder.credentialsSecret.Definition.DeepCopy())
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("can not delete bmh credentials secret: %w", err)
+	}
+
+	builder.credentialsSecret.Object = nil
+
+	return nil
+}
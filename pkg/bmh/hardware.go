@@ -0,0 +1,258 @@
+package bmh
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/golang/glog"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+)
+
+// inspectAnnotation is the annotation requesting metal3 to inspect the host again. Metal3 removes it once the
+// inspection started.
+const inspectAnnotation = "inspect.metal3.io"
+
+// WaitUntilInspected waits for timeout duration or until the bmh reports the hardware details collected during
+// inspection and is no longer registering or inspecting.
+func (builder *BmhBuilder) WaitUntilInspected(timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for baremetalhost %s in namespace %s to be inspected",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	var lastObserved string
+
+	err := await.PollWithContext(timeout, func(ctx context.Context) (bool, error) {
+		bmh, err := builder.GetWithContext(ctx)
+		if err != nil {
+			return false, nil
+		}
+
+		builder.Object = bmh
+		state := bmh.Status.Provisioning.State
+		lastObserved = fmt.Sprintf("provisioning state %q, hardware details present %t",
+			state, bmh.Status.HardwareDetails != nil)
+
+		switch state {
+		case bmhv1alpha1.StateNone, bmhv1alpha1.StateRegistering, bmhv1alpha1.StateInspecting:
+			return false, nil
+		}
+
+		return bmh.Status.HardwareDetails != nil, nil
+	}, options...)
+
+	return builder.withTimeoutDetails(err, "hardware inspection completed", lastObserved)
+}
+
+// TriggerInspection sets the inspect annotation on the bmh so that metal3 inspects the host again and refreshes
+// its hardware details. The trigger time is recorded so that WaitUntilInspectionCompleted ignores earlier
+// inspections.
+func (builder *BmhBuilder) TriggerInspection() (*BmhBuilder, error) {
+	return builder.TriggerInspectionWithContext(context.TODO())
+}
+
+// TriggerInspectionWithContext behaves like TriggerInspection and cancels its requests when ctx is done.
+func (builder *BmhBuilder) TriggerInspectionWithContext(ctx context.Context) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Triggering inspection of baremetalhost %s in namespace %s",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	triggeredAt := time.Now().Truncate(time.Second)
+
+	err := builder.patch(ctx, func(bmh *bmhv1alpha1.BareMetalHost) {
+		if bmh.Annotations == nil {
+			bmh.Annotations = make(map[string]string)
+		}
+
+		bmh.Annotations[inspectAnnotation] = ""
+	})
+	if err != nil {
+		return builder, fmt.Errorf("failed to trigger bmh inspection: %w", err)
+	}
+
+	builder.inspectionTriggeredAt = triggeredAt
+
+	return builder, nil
+}
+
+// WaitUntilInspectionCompleted waits for timeout duration or until metal3 removed the inspect annotation and
+// finished the inspection it started, as recorded in the inspect operation history, with hardware details present.
+// If TriggerInspection was called on the builder, the inspection must have started and ended after it.
+func (builder *BmhBuilder) WaitUntilInspectionCompleted(timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for inspection of baremetalhost %s in namespace %s to complete",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	var lastObserved string
+
+	err := await.PollWithContext(timeout, func(ctx context.Context) (bool, error) {
+		bmh, err := builder.GetWithContext(ctx)
+		if err != nil {
+			return false, nil
+		}
+
+		builder.Object = bmh
+		_, pending := bmh.Annotations[inspectAnnotation]
+		inspect := bmh.Status.OperationHistory.Inspect
+		finished := !inspect.End.IsZero() && !inspect.End.Before(&inspect.Start) &&
+			!inspect.Start.Time.Before(builder.inspectionTriggeredAt)
+		lastObserved = fmt.Sprintf("inspection pending %t, provisioning state %q, last inspection from %s to %s",
+			pending, bmh.Status.Provisioning.State, inspect.Start, inspect.End)
+
+		if pending || bmh.Status.Provisioning.State == bmhv1alpha1.StateInspecting {
+			return false, nil
+		}
+
+		return finished && bmh.Status.HardwareDetails != nil, nil
+	}, options...)
+
+	return builder.withTimeoutDetails(err, "inspection completed with hardware details", lastObserved)
+}
+
+// GetHardwareDetails returns the hardware details collected during the bmh inspection.
+func (builder *BmhBuilder) GetHardwareDetails() (*bmhv1alpha1.HardwareDetails, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting hardware details of baremetalhost %s in namespace %s",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("bmh %s in namespace %s does not exist", builder.ObjectName(), builder.ObjectNamespace())
+	}
+
+	if builder.Object.Status.HardwareDetails == nil {
+		return nil, fmt.Errorf("bmh %s in namespace %s has not been inspected yet",
+			builder.ObjectName(), builder.ObjectNamespace())
+	}
+
+	return builder.Object.Status.HardwareDetails, nil
+}
+
+// GetNICs returns the network interfaces discovered during the bmh inspection.
+func (builder *BmhBuilder) GetNICs() ([]bmhv1alpha1.NIC, error) {
+	hardwareDetails, err := builder.GetHardwareDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	return hardwareDetails.NIC, nil
+}
+
+// GetDisks returns the storage devices discovered during the bmh inspection.
+func (builder *BmhBuilder) GetDisks() ([]bmhv1alpha1.Storage, error) {
+	hardwareDetails, err := builder.GetHardwareDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	return hardwareDetails.Storage, nil
+}
+
+// GetCPUDetails returns the CPU discovered during the bmh inspection.
+func (builder *BmhBuilder) GetCPUDetails() (*bmhv1alpha1.CPU, error) {
+	hardwareDetails, err := builder.GetHardwareDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	return &hardwareDetails.CPU, nil
+}
+
+// GetDiskByWWN returns the storage device with the given WWN discovered during the bmh inspection.
+func (builder *BmhBuilder) GetDiskByWWN(wwn string) (*bmhv1alpha1.Storage, error) {
+	if wwn == "" {
+		glog.V(100).Infof("The disk wwn is empty")
+
+		return nil, fmt.Errorf("disk 'wwn' cannot be empty")
+	}
+
+	disks, err := builder.GetDisks()
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range disks {
+		if disks[index].WWN == wwn {
+			return &disks[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("bmh %s in namespace %s has no disk with wwn %s",
+		builder.ObjectName(), builder.ObjectNamespace(), wwn)
+}
+
+// PopulateBootMACFromInventory sets the boot MAC address of the bmh to the MAC of a NIC discovered during inspection
+// whose name matches nicPattern, for labs where the MAC addresses are not known before enrolment. A PXE capable
+// match is preferred over the first match.
+func (builder *BmhBuilder) PopulateBootMACFromInventory(nicPattern string) (*BmhBuilder, error) {
+	return builder.PopulateBootMACFromInventoryWithContext(context.TODO(), nicPattern)
+}
+
+// PopulateBootMACFromInventoryWithContext behaves like PopulateBootMACFromInventory and cancels its requests when
+// ctx is done.
+func (builder *BmhBuilder) PopulateBootMACFromInventoryWithContext(
+	ctx context.Context, nicPattern string) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Populating boot MAC address of baremetalhost %s in namespace %s from NIC matching %s",
+		builder.ObjectName(), builder.ObjectNamespace(), nicPattern)
+
+	if nicPattern == "" {
+		glog.V(100).Infof("The NIC name pattern is empty")
+
+		return builder, fmt.Errorf("NIC name pattern cannot be empty")
+	}
+
+	nicRegex, err := regexp.Compile(nicPattern)
+	if err != nil {
+		return builder, fmt.Errorf("invalid NIC name pattern %s: %w", nicPattern, err)
+	}
+
+	nics, err := builder.GetNICs()
+	if err != nil {
+		return builder, err
+	}
+
+	var bootNIC *bmhv1alpha1.NIC
+
+	for index := range nics {
+		if nics[index].MAC == "" || !nicRegex.MatchString(nics[index].Name) {
+			continue
+		}
+
+		if bootNIC == nil || (!bootNIC.PXE && nics[index].PXE) {
+			bootNIC = &nics[index]
+		}
+	}
+
+	if bootNIC == nil {
+		return builder, fmt.Errorf("bmh %s in namespace %s has no NIC matching %s",
+			builder.ObjectName(), builder.ObjectNamespace(), nicPattern)
+	}
+
+	glog.V(100).Infof("Using NIC %s with MAC address %s as boot interface", bootNIC.Name, bootNIC.MAC)
+
+	err = builder.patch(ctx, func(bmh *bmhv1alpha1.BareMetalHost) {
+		bmh.Spec.BootMACAddress = bootNIC.MAC
+	})
+	if err != nil {
+		return builder, fmt.Errorf("failed to set bmh boot MAC address: %w", err)
+	}
+
+	return builder, nil
+}
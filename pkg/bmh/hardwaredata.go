@@ -0,0 +1,206 @@
+package bmh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/glog"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HardwareDataBuilder provides struct for the hardwaredata object containing connection to
+// the cluster and the hardwaredata definitions.
+type HardwareDataBuilder struct {
+	// HardwareData definition. Used to store the hardwaredata object.
+	Definition *bmhv1alpha1.HardwareData
+	// Created hardwaredata object.
+	Object *bmhv1alpha1.HardwareData
+	// Used to store the error messages upon defining or mutating hardwaredata definition.
+	errorMsg string
+	// apiClient opens api connection to the cluster.
+	apiClient *clients.Settings
+}
+
+// PullHardwareData pulls the existing hardwaredata of the bmh with the given name from the cluster. The
+// hardwaredata object is named after the bmh it belongs to and outlives the detachment of the bmh.
+func PullHardwareData(apiClient *clients.Settings, name, nsname string) (*HardwareDataBuilder, error) {
+	glog.V(100).Infof("Pulling existing hardwaredata name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient of the hardwaredata is nil")
+
+		return nil, fmt.Errorf("hardwaredata 'apiClient' cannot be nil")
+	}
+
+	builder := HardwareDataBuilder{
+		apiClient: apiClient,
+		Definition: &bmhv1alpha1.HardwareData{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the hardwaredata is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "hardwaredata 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the hardwaredata is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "hardwaredata 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("hardwaredata object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// GetHardwareDetails returns the hardware inventory stored in the hardwaredata.
+func (builder *HardwareDataBuilder) GetHardwareDetails() (*bmhv1alpha1.HardwareDetails, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting hardware details of hardwaredata %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("hardwaredata object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Spec.HardwareDetails == nil {
+		return nil, fmt.Errorf("hardwaredata %s in namespace %s has no hardware details",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Spec.HardwareDetails, nil
+}
+
+// MatchesBmh checks whether the hardware inventory stored in the hardwaredata is the same as the one reported in
+// the status of the given bmh.
+func (builder *HardwareDataBuilder) MatchesBmh(bmhBuilder *BmhBuilder) (bool, error) {
+	hardwareDataDetails, err := builder.GetHardwareDetails()
+	if err != nil {
+		return false, err
+	}
+
+	bmhDetails, err := bmhBuilder.GetHardwareDetails()
+	if err != nil {
+		return false, err
+	}
+
+	return equality.Semantic.DeepEqual(hardwareDataDetails, bmhDetails), nil
+}
+
+// Delete removes the hardwaredata from the cluster.
+func (builder *HardwareDataBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting hardwaredata %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		glog.V(100).Infof("hardwaredata %s in namespace %s does not exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Delete(context.TODO(), builder.Object)
+	if err != nil {
+		return fmt.Errorf("can not delete hardwaredata: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// Exists checks whether the given hardwaredata exists.
+func (builder *HardwareDataBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if hardwaredata %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil
+}
+
+// Get returns the hardwaredata object if found.
+func (builder *HardwareDataBuilder) Get() (*bmhv1alpha1.HardwareData, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting hardwaredata %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	hardwareData := &bmhv1alpha1.HardwareData{}
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, hardwareData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hardwareData, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *HardwareDataBuilder) validate() (bool, error) {
+	resourceCRD := "HardwareData"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, errors.New(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,266 @@
+package bmh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HFSBuilder provides struct for the hostfirmwaresettings object containing connection to
+// the cluster and the hostfirmwaresettings definitions.
+type HFSBuilder struct {
+	// HostFirmwareSettings definition. Used to store the hostfirmwaresettings object.
+	Definition *bmhv1alpha1.HostFirmwareSettings
+	// Created hostfirmwaresettings object.
+	Object *bmhv1alpha1.HostFirmwareSettings
+	// Used in functions that define or mutate the hostfirmwaresettings definition.
+	// errorMsg is processed before the object is updated.
+	errorMsg string
+	// apiClient opens api connection to the cluster.
+	apiClient *clients.Settings
+}
+
+// PullHFS pulls the existing hostfirmwaresettings of the bmh with the given name from the cluster. The
+// hostfirmwaresettings object is named after the bmh it belongs to.
+func PullHFS(apiClient *clients.Settings, name, nsname string) (*HFSBuilder, error) {
+	glog.V(100).Infof("Pulling existing hostfirmwaresettings name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient of the hostfirmwaresettings is nil")
+
+		return nil, fmt.Errorf("hostfirmwaresettings 'apiClient' cannot be nil")
+	}
+
+	builder := HFSBuilder{
+		apiClient: apiClient,
+		Definition: &bmhv1alpha1.HostFirmwareSettings{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the hostfirmwaresettings is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "hostfirmwaresettings 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the hostfirmwaresettings is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "hostfirmwaresettings 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("hostfirmwaresettings object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithSetting sets the desired value of the given firmware setting in the hostfirmwaresettings definition.
+func (builder *HFSBuilder) WithSetting(name string, value intstr.IntOrString) *HFSBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting firmware setting %s to %s in hostfirmwaresettings %s in namespace %s",
+		name, value.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	if name == "" {
+		glog.V(100).Infof("The firmware setting name is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "hostfirmwaresettings setting 'name' cannot be empty")
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Settings == nil {
+		builder.Definition.Spec.Settings = make(bmhv1alpha1.DesiredSettingsMap)
+	}
+
+	builder.Definition.Spec.Settings[name] = value
+
+	return builder
+}
+
+// Update renovates the existing hostfirmwaresettings object with the hostfirmwaresettings definition in builder.
+func (builder *HFSBuilder) Update() (*HFSBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating hostfirmwaresettings %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("hostfirmwaresettings object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
+// GetSettings returns the current firmware settings reported in the hostfirmwaresettings status.
+func (builder *HFSBuilder) GetSettings() (bmhv1alpha1.SettingsMap, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting current settings of hostfirmwaresettings %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("hostfirmwaresettings object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Settings, nil
+}
+
+// WaitUntilChangeDetected waits for timeout duration or until the hostfirmwaresettings reports a difference
+// between the desired and the current settings.
+func (builder *HFSBuilder) WaitUntilChangeDetected(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.waitUntilConditionTrue(bmhv1alpha1.FirmwareSettingsChangeDetected, timeout, options...)
+}
+
+// WaitUntilValid waits for timeout duration or until the hostfirmwaresettings reports the desired settings as
+// valid.
+func (builder *HFSBuilder) WaitUntilValid(timeout time.Duration, options ...await.WaitOption) error {
+	return builder.waitUntilConditionTrue(bmhv1alpha1.FirmwareSettingsValid, timeout, options...)
+}
+
+// Exists checks whether the given hostfirmwaresettings exists.
+func (builder *HFSBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if hostfirmwaresettings %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil
+}
+
+// Get returns the hostfirmwaresettings object if found.
+func (builder *HFSBuilder) Get() (*bmhv1alpha1.HostFirmwareSettings, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting hostfirmwaresettings %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	hfs := &bmhv1alpha1.HostFirmwareSettings{}
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, hfs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hfs, nil
+}
+
+// waitUntilConditionTrue waits for timeout duration or until the given condition is true.
+func (builder *HFSBuilder) waitUntilConditionTrue(
+	conditionType bmhv1alpha1.SettingsConditionType, timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for hostfirmwaresettings %s in namespace %s to have condition %s true",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType)
+
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
+		hfs, err := builder.Get()
+		if err != nil {
+			return false, nil
+		}
+
+		builder.Object = hfs
+
+		condition := meta.FindStatusCondition(hfs.Status.Conditions, string(conditionType))
+		if condition == nil {
+			lastObserved = fmt.Sprintf("no condition %s", conditionType)
+
+			return false, nil
+		}
+
+		lastObserved = fmt.Sprintf("condition %s %s: %s", conditionType, condition.Status, condition.Message)
+
+		return condition.Status == metaV1.ConditionTrue, nil
+	}, options...)
+
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          bmhv1alpha1.GroupVersion.WithKind("HostFirmwareSettings"),
+		Name:         builder.Definition.Name,
+		Namespace:    builder.Definition.Namespace,
+		Wanted:       fmt.Sprintf("condition %s true", conditionType),
+		LastObserved: lastObserved,
+	})
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *HFSBuilder) validate() (bool, error) {
+	resourceCRD := "HostFirmwareSettings"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, errors.New(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,254 @@
+package bmh
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/golang/glog"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// InventoryFormat is the output format of ExportInventory.
+type InventoryFormat string
+
+const (
+	// InventoryFormatJSON writes the inventory as an indented JSON array.
+	InventoryFormatJSON InventoryFormat = "json"
+	// InventoryFormatCSV writes the inventory as CSV with a header row.
+	InventoryFormatCSV InventoryFormat = "csv"
+)
+
+// InventoryTag is the label key of a standardized inventory tag of a bmh, used to select shared lab hardware.
+type InventoryTag string
+
+const (
+	// InventoryTagRack is the rack the host is mounted in.
+	InventoryTagRack InventoryTag = "inventory.eco-goinfra.openshift-kni.io/rack"
+	// InventoryTagLab is the lab the host belongs to.
+	InventoryTagLab InventoryTag = "inventory.eco-goinfra.openshift-kni.io/lab"
+	// InventoryTagOwner is the team or person currently owning the host.
+	InventoryTagOwner InventoryTag = "inventory.eco-goinfra.openshift-kni.io/owner"
+)
+
+// InventoryEntry summarizes the state and hardware of a single bmh.
+type InventoryEntry struct {
+	Name              string `json:"name"`
+	Namespace         string `json:"namespace"`
+	PoweredOn         bool   `json:"poweredOn"`
+	ProvisioningState string `json:"provisioningState"`
+	OperationalStatus string `json:"operationalStatus"`
+	// Consumer is the consumer of the bmh as kind/namespace/name. It is empty if the bmh is not consumed.
+	Consumer       string `json:"consumer,omitempty"`
+	BootMACAddress string `json:"bootMACAddress"`
+	Manufacturer   string `json:"manufacturer,omitempty"`
+	ProductName    string `json:"productName,omitempty"`
+	CPUModel       string `json:"cpuModel,omitempty"`
+	CPUCount       int    `json:"cpuCount"`
+	RAMMebibytes   int    `json:"ramMebibytes"`
+	DiskCount      int    `json:"diskCount"`
+	NICCount       int    `json:"nicCount"`
+	// HardwareProfile is the hardware profile detected by metal3, or the one of the spec if none was detected.
+	HardwareProfile string `json:"hardwareProfile,omitempty"`
+	Rack            string `json:"rack,omitempty"`
+	Lab             string `json:"lab,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+}
+
+// inventoryCSVHeader is the header row of the CSV inventory, in the order of the InventoryEntry fields.
+var inventoryCSVHeader = []string{
+	"name", "namespace", "poweredOn", "provisioningState", "operationalStatus", "consumer", "bootMACAddress",
+	"manufacturer", "productName", "cpuModel", "cpuCount", "ramMebibytes", "diskCount", "nicCount", "hardwareProfile",
+	"rack", "lab", "owner",
+}
+
+// GetInventory returns an inventory entry for every bmh in the given namespaces, or in all namespaces if none is
+// given.
+func GetInventory(apiClient *clients.Settings, nsnames ...string) ([]InventoryEntry, error) {
+	glog.V(100).Infof("Collecting baremetalhost inventory in namespaces %v", nsnames)
+
+	var (
+		bmhBuilders []*BmhBuilder
+		err         error
+	)
+
+	if len(nsnames) == 0 {
+		bmhBuilders, err = ListAll(apiClient)
+	} else {
+		bmhBuilders, err = ListInNamespaces(apiClient, nsnames)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := make([]InventoryEntry, 0, len(bmhBuilders))
+
+	for _, bmhBuilder := range bmhBuilders {
+		inventory = append(inventory, newInventoryEntry(bmhBuilder))
+	}
+
+	return inventory, nil
+}
+
+// ExportInventory collects the inventory of the bmhs in the given namespaces, or in all namespaces if none is given,
+// and writes it to writer in the given format.
+func ExportInventory(
+	apiClient *clients.Settings, writer io.Writer, format InventoryFormat, nsnames ...string) error {
+	if writer == nil {
+		glog.V(100).Infof("The inventory writer is nil")
+
+		return fmt.Errorf("failed to export inventory, 'writer' parameter is nil")
+	}
+
+	inventory, err := GetInventory(apiClient, nsnames...)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case InventoryFormatJSON:
+		return WriteInventoryJSON(writer, inventory)
+	case InventoryFormatCSV:
+		return WriteInventoryCSV(writer, inventory)
+	default:
+		return fmt.Errorf("not acceptable inventory format %q", format)
+	}
+}
+
+// WriteInventoryJSON writes the inventory to writer as an indented JSON array.
+func WriteInventoryJSON(writer io.Writer, inventory []InventoryEntry) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(inventory); err != nil {
+		return fmt.Errorf("failed to write inventory as JSON: %w", err)
+	}
+
+	return nil
+}
+
+// WriteInventoryCSV writes the inventory to writer as CSV with a header row.
+func WriteInventoryCSV(writer io.Writer, inventory []InventoryEntry) error {
+	csvWriter := csv.NewWriter(writer)
+
+	if err := csvWriter.Write(inventoryCSVHeader); err != nil {
+		return fmt.Errorf("failed to write inventory CSV header: %w", err)
+	}
+
+	for _, entry := range inventory {
+		record := []string{
+			entry.Name,
+			entry.Namespace,
+			strconv.FormatBool(entry.PoweredOn),
+			entry.ProvisioningState,
+			entry.OperationalStatus,
+			entry.Consumer,
+			entry.BootMACAddress,
+			entry.Manufacturer,
+			entry.ProductName,
+			entry.CPUModel,
+			strconv.Itoa(entry.CPUCount),
+			strconv.Itoa(entry.RAMMebibytes),
+			strconv.Itoa(entry.DiskCount),
+			strconv.Itoa(entry.NICCount),
+			entry.HardwareProfile,
+			entry.Rack,
+			entry.Lab,
+			entry.Owner,
+		}
+
+		if err := csvWriter.Write(record); err != nil {
+			return fmt.Errorf("failed to write inventory CSV record for %s: %w", entry.Name, err)
+		}
+	}
+
+	csvWriter.Flush()
+
+	if err := csvWriter.Error(); err != nil {
+		return fmt.Errorf("failed to write inventory as CSV: %w", err)
+	}
+
+	return nil
+}
+
+// newInventoryEntry summarizes the object of the given builder.
+func newInventoryEntry(bmhBuilder *BmhBuilder) InventoryEntry {
+	bmh := bmhBuilder.Object
+
+	entry := InventoryEntry{
+		Name:              bmh.Name,
+		Namespace:         bmh.Namespace,
+		PoweredOn:         bmh.Status.PoweredOn,
+		ProvisioningState: string(bmh.Status.Provisioning.State),
+		OperationalStatus: string(bmh.Status.OperationalStatus),
+		BootMACAddress:    bmh.Spec.BootMACAddress,
+		HardwareProfile:   hardwareProfile(bmh),
+		Rack:              bmh.Labels[string(InventoryTagRack)],
+		Lab:               bmh.Labels[string(InventoryTagLab)],
+		Owner:             bmh.Labels[string(InventoryTagOwner)],
+	}
+
+	if bmh.Spec.ConsumerRef != nil {
+		entry.Consumer = fmt.Sprintf("%s/%s/%s",
+			bmh.Spec.ConsumerRef.Kind, bmh.Spec.ConsumerRef.Namespace, bmh.Spec.ConsumerRef.Name)
+	}
+
+	if hardware := bmh.Status.HardwareDetails; hardware != nil {
+		entry.Manufacturer = hardware.SystemVendor.Manufacturer
+		entry.ProductName = hardware.SystemVendor.ProductName
+		entry.CPUModel = hardware.CPU.Model
+		entry.CPUCount = hardware.CPU.Count
+		entry.RAMMebibytes = hardware.RAMMebibytes
+		entry.DiskCount = len(hardware.Storage)
+		entry.NICCount = len(hardware.NIC)
+	}
+
+	return entry
+}
+
+// WithInventoryTag sets the given inventory tag on the bmh definition. The value must be a valid label value.
+func (builder *BmhBuilder) WithInventoryTag(tag InventoryTag, value string) *BmhBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Tagging baremetalhost %s in namespace %s with %s=%s",
+		builder.ObjectName(), builder.ObjectNamespace(), tag, value)
+
+	switch tag {
+	case InventoryTagRack, InventoryTagLab, InventoryTagOwner:
+	default:
+		glog.V(100).Infof("The baremetalhost inventory tag %s is not supported", tag)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg,
+			fmt.Sprintf("not acceptable baremetalhost inventory tag %q", tag))
+	}
+
+	if errs := validation.IsValidLabelValue(value); value == "" || len(errs) > 0 {
+		glog.V(100).Infof("The baremetalhost inventory tag value %q is not a valid label value", value)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg,
+			fmt.Sprintf("the baremetalhost inventory tag value %q is not a valid label value", value))
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	return builder.WithLabel(string(tag), value)
+}
+
+// hardwareProfile returns the hardware profile detected by metal3, or the one of the spec if none was detected.
+func hardwareProfile(bmh *bmhv1alpha1.BareMetalHost) string {
+	if bmh.Status.HardwareProfile != "" {
+		return bmh.Status.HardwareProfile
+	}
+
+	return bmh.Spec.HardwareProfile
+}
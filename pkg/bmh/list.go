@@ -0,0 +1,273 @@
+package bmh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// List returns bmh inventory in the given namespace. At most one ListOptions can be passed to filter the hosts by
+// label or field selector and to limit the number of returned hosts.
+func List(apiClient *clients.Settings, nsname string, options ...metaV1.ListOptions) ([]*BmhBuilder, error) {
+	return ListWithContext(context.TODO(), apiClient, nsname, options...)
+}
+
+// ListWithContext behaves like List and cancels its request when ctx is done.
+func ListWithContext(ctx context.Context,
+	apiClient *clients.Settings, nsname string, options ...metaV1.ListOptions) ([]*BmhBuilder, error) {
+	glog.V(100).Infof("Listing baremetalhosts in the nsname %s with the options %v", nsname, options)
+
+	if apiClient == nil {
+		glog.V(100).Infof("baremetalhosts 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list baremetalhosts, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("baremetalhost 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list baremetalhosts, 'nsname' parameter is empty")
+	}
+
+	return listBmhs(ctx, apiClient, nsname, options)
+}
+
+// ListInNamespaces returns bmh inventory in all of the given namespaces. The same optional ListOptions are applied to
+// every namespace.
+func ListInNamespaces(
+	apiClient *clients.Settings, nsnames []string, options ...metaV1.ListOptions) ([]*BmhBuilder, error) {
+	return ListInNamespacesWithContext(context.TODO(), apiClient, nsnames, options...)
+}
+
+// ListInNamespacesWithContext behaves like ListInNamespaces and cancels its requests when ctx is done.
+func ListInNamespacesWithContext(ctx context.Context,
+	apiClient *clients.Settings, nsnames []string, options ...metaV1.ListOptions) ([]*BmhBuilder, error) {
+	glog.V(100).Infof("Listing baremetalhosts in the namespaces %v with the options %v", nsnames, options)
+
+	if apiClient == nil {
+		glog.V(100).Infof("baremetalhosts 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list baremetalhosts, 'apiClient' parameter is empty")
+	}
+
+	if len(nsnames) == 0 {
+		glog.V(100).Infof("baremetalhost 'nsnames' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list baremetalhosts, 'nsnames' parameter is empty")
+	}
+
+	var (
+		bmhObjects []*BmhBuilder
+		listed     = make(map[string]bool)
+	)
+
+	for _, nsname := range nsnames {
+		if nsname == "" {
+			glog.V(100).Infof("baremetalhost 'nsnames' parameter can not contain an empty namespace")
+
+			return nil, fmt.Errorf("failed to list baremetalhosts, 'nsnames' parameter contains an empty namespace")
+		}
+
+		if listed[nsname] {
+			continue
+		}
+
+		listed[nsname] = true
+
+		namespaceBmhs, err := listBmhs(ctx, apiClient, nsname, options)
+		if err != nil {
+			return nil, err
+		}
+
+		bmhObjects = append(bmhObjects, namespaceBmhs...)
+	}
+
+	return bmhObjects, nil
+}
+
+// ListAll returns bmh inventory across all namespaces of the cluster.
+func ListAll(apiClient *clients.Settings, options ...metaV1.ListOptions) ([]*BmhBuilder, error) {
+	return ListAllWithContext(context.TODO(), apiClient, options...)
+}
+
+// ListAllWithContext behaves like ListAll and cancels its request when ctx is done.
+func ListAllWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...metaV1.ListOptions) ([]*BmhBuilder, error) {
+	glog.V(100).Infof("Listing baremetalhosts in all namespaces with the options %v", options)
+
+	if apiClient == nil {
+		glog.V(100).Infof("baremetalhosts 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list baremetalhosts, 'apiClient' parameter is empty")
+	}
+
+	return listBmhs(ctx, apiClient, metaV1.NamespaceAll, options)
+}
+
+// FindOlderThan returns the bmhs across all namespaces matching the optional ListOptions that were created more than
+// age ago, e.g. hosts left behind by aborted test runs.
+func FindOlderThan(
+	apiClient *clients.Settings, age time.Duration, options ...metaV1.ListOptions) ([]*BmhBuilder, error) {
+	glog.V(100).Infof("Finding baremetalhosts older than %s with the options %v", age, options)
+
+	bmhBuilders, err := ListAll(apiClient, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	var staleBmhs []*BmhBuilder
+
+	for _, bmhBuilder := range bmhBuilders {
+		if time.Since(bmhBuilder.Object.CreationTimestamp.Time) > age {
+			staleBmhs = append(staleBmhs, bmhBuilder)
+		}
+	}
+
+	return staleBmhs, nil
+}
+
+// ListByHardwareProfile returns the bmhs across all namespaces matching the optional ListOptions whose hardware
+// profile is the given one. The profile detected by metal3 is compared, or the one of the spec if none was detected.
+func ListByHardwareProfile(
+	apiClient *clients.Settings, hardwareProfileName string, options ...metaV1.ListOptions) ([]*BmhBuilder, error) {
+	glog.V(100).Infof("Listing baremetalhosts with hardware profile %s and the options %v", hardwareProfileName, options)
+
+	if hardwareProfileName == "" {
+		glog.V(100).Infof("baremetalhost 'hardwareProfileName' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list baremetalhosts, 'hardwareProfileName' parameter is empty")
+	}
+
+	bmhBuilders, err := ListAll(apiClient, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchingBmhs []*BmhBuilder
+
+	for _, bmhBuilder := range bmhBuilders {
+		if hardwareProfile(bmhBuilder.Object) == hardwareProfileName {
+			matchingBmhs = append(matchingBmhs, bmhBuilder)
+		}
+	}
+
+	return matchingBmhs, nil
+}
+
+// DeleteAllOf removes all bmhs in the given namespace matching the optional ListOptions with a single DeleteAllOf
+// call. The call does not wait for the hosts to be deprovisioned and removed.
+func DeleteAllOf(apiClient *clients.Settings, nsname string, options ...metaV1.ListOptions) error {
+	return DeleteAllOfWithContext(context.TODO(), apiClient, nsname, options...)
+}
+
+// DeleteAllOfWithContext behaves like DeleteAllOf and cancels its request when ctx is done.
+func DeleteAllOfWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname string, options ...metaV1.ListOptions) error {
+	glog.V(100).Infof("Deleting all baremetalhosts in the nsname %s with the options %v", nsname, options)
+
+	if apiClient == nil {
+		glog.V(100).Infof("baremetalhosts 'apiClient' parameter can not be empty")
+
+		return fmt.Errorf("failed to delete baremetalhosts, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("baremetalhost 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to delete baremetalhosts, 'nsname' parameter is empty")
+	}
+
+	listOptions, err := getListOptions(nsname, options)
+	if err != nil {
+		return err
+	}
+
+	err = apiClient.DeleteAllOf(ctx, &bmhv1alpha1.BareMetalHost{},
+		&goclient.DeleteAllOfOptions{ListOptions: *listOptions})
+	if err != nil {
+		glog.V(100).Infof("Failed to delete baremetalhosts in the nsname %s due to %s", nsname, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// listBmhs lists the bmhs in the given namespace, or in all namespaces if nsname is empty.
+func listBmhs(ctx context.Context,
+	apiClient *clients.Settings, nsname string, options []metaV1.ListOptions) ([]*BmhBuilder, error) {
+	listOptions, err := getListOptions(nsname, options)
+	if err != nil {
+		return nil, err
+	}
+
+	bmhList := &bmhv1alpha1.BareMetalHostList{}
+
+	err = apiClient.List(ctx, bmhList, listOptions)
+	if err != nil {
+		glog.V(100).Infof("Failed to list baremetalhosts in the nsname %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var bmhObjects []*BmhBuilder
+
+	for _, bareMetalHost := range bmhList.Items {
+		copiedBmh := bareMetalHost
+		bmhBuilder := &BmhBuilder{
+			apiClient:  apiClient,
+			Object:     &copiedBmh,
+			Definition: &copiedBmh,
+		}
+
+		bmhObjects = append(bmhObjects, bmhBuilder)
+	}
+
+	return bmhObjects, nil
+}
+
+// getListOptions converts the optional metaV1.ListOptions into controller-runtime list options.
+func getListOptions(nsname string, options []metaV1.ListOptions) (*goclient.ListOptions, error) {
+	if len(options) > 1 {
+		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	listOptions := &goclient.ListOptions{Namespace: nsname}
+
+	if len(options) == 0 {
+		return listOptions, nil
+	}
+
+	if options[0].LabelSelector != "" {
+		labelSelector, err := labels.Parse(options[0].LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse label selector %s: %w", options[0].LabelSelector, err)
+		}
+
+		listOptions.LabelSelector = labelSelector
+	}
+
+	if options[0].FieldSelector != "" {
+		fieldSelector, err := fields.ParseSelector(options[0].FieldSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse field selector %s: %w", options[0].FieldSelector, err)
+		}
+
+		listOptions.FieldSelector = fieldSelector
+	}
+
+	listOptions.Limit = options[0].Limit
+	listOptions.Continue = options[0].Continue
+
+	return listOptions, nil
+}
@@ -0,0 +1,135 @@
+package bmh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/monitoring"
+)
+
+const (
+	// metricsHostNamespaceLabel is the label carrying the namespace of the bmh in the metal3 metrics.
+	metricsHostNamespaceLabel = "namespace"
+	// metricsHostNameLabel is the label carrying the name of the bmh in the metal3 metrics.
+	metricsHostNameLabel = "host"
+	// operationDurationMetricFormat is the name of the histogram of the duration of a metal3 host operation.
+	operationDurationMetricFormat = "metal3_operation_%s_duration_seconds"
+)
+
+// Operation is a host operation whose duration is exported by the baremetal-operator.
+type Operation string
+
+const (
+	// OperationRegister is the registration of the host with ironic.
+	OperationRegister Operation = "register"
+	// OperationInspect is the inspection of the host hardware.
+	OperationInspect Operation = "inspect"
+	// OperationProvision is the provisioning of an image on the host.
+	OperationProvision Operation = "provision"
+	// OperationDeprovision is the deprovisioning and cleaning of the host.
+	OperationDeprovision Operation = "deprovision"
+)
+
+// MetricsSource locates the metrics endpoint of the baremetal-operator. The metrics are read through the service
+// proxy of the API server, so the endpoint must be served by a port of the given service.
+type MetricsSource struct {
+	Namespace string
+	Service   string
+	// Port is the name or the number of the metrics port of the service.
+	Port string
+	// Scheme is http or https. If empty, the API server picks it.
+	Scheme string
+	Path   string
+}
+
+// DefaultMetricsSource is the baremetal-operator deployed by the cluster-baremetal-operator on OpenShift. It can be
+// changed for other deployments.
+var DefaultMetricsSource = MetricsSource{
+	Namespace: "openshift-machine-api",
+	Service:   "metal3-baremetal-operator",
+	Port:      "https",
+	Scheme:    "https",
+	Path:      "/metrics",
+}
+
+// HostMetrics are the metal3 metric samples labeled with a single bmh.
+type HostMetrics struct {
+	Name      string
+	Namespace string
+	Samples   []monitoring.Sample
+}
+
+// Get returns the samples of the metric with the given name.
+func (metrics *HostMetrics) Get(name string) []monitoring.Sample {
+	return monitoring.FilterSamples(metrics.Samples, name)
+}
+
+// Value returns the sum of the values of all samples of the metric with the given name, e.g. the errors counted
+// for the host across all label combinations.
+func (metrics *HostMetrics) Value(name string) float64 {
+	var value float64
+
+	for _, sample := range metrics.Get(name) {
+		value += sample.Value
+	}
+
+	return value
+}
+
+// OperationDuration returns the total time the baremetal-operator spent in the given operation for the host and
+// the number of times the operation ran. The count is 0 if the operation was never observed.
+func (metrics *HostMetrics) OperationDuration(operation Operation) (time.Duration, uint64) {
+	var (
+		seconds float64
+		count   uint64
+	)
+
+	for _, sample := range metrics.Get(fmt.Sprintf(operationDurationMetricFormat, operation)) {
+		seconds += sample.Value
+		count += sample.Count
+	}
+
+	return time.Duration(seconds * float64(time.Second)), count
+}
+
+// ScrapeMetrics reads the metrics of the baremetal-operator from the given source and returns all samples.
+func ScrapeMetrics(apiClient *clients.Settings, source MetricsSource) ([]monitoring.Sample, error) {
+	glog.V(100).Infof("Scraping metal3 metrics from service %s in namespace %s", source.Service, source.Namespace)
+
+	samples, err := monitoring.ScrapeService(
+		apiClient, source.Namespace, source.Scheme, source.Service, source.Port, source.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrape metal3 metrics: %w", err)
+	}
+
+	return samples, nil
+}
+
+// GetMetrics scrapes the metrics of the baremetal-operator from the given source and returns the samples labeled
+// with the bmh of the builder. It allows asserting on provisioning durations and error counts of a specific host.
+func (builder *BmhBuilder) GetMetrics(source MetricsSource) (*HostMetrics, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting metal3 metrics of baremetalhost %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	samples, err := ScrapeMetrics(builder.apiClient, source)
+	if err != nil {
+		return nil, err
+	}
+
+	hostMetrics := &HostMetrics{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace}
+
+	for _, sample := range samples {
+		if sample.Labels[metricsHostNamespaceLabel] == hostMetrics.Namespace &&
+			sample.Labels[metricsHostNameLabel] == hostMetrics.Name {
+			hostMetrics.Samples = append(hostMetrics.Samples, sample)
+		}
+	}
+
+	return hostMetrics, nil
+}
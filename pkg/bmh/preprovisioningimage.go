@@ -0,0 +1,245 @@
+package bmh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PreprovisioningImageBuilder provides struct for the preprovisioningimage object containing connection to
+// the cluster and the preprovisioningimage definitions.
+type PreprovisioningImageBuilder struct {
+	// PreprovisioningImage definition. Used to store the preprovisioningimage object.
+	Definition *bmhv1alpha1.PreprovisioningImage
+	// Created preprovisioningimage object.
+	Object *bmhv1alpha1.PreprovisioningImage
+	// Used to store the error messages upon defining or mutating preprovisioningimage definition.
+	errorMsg string
+	// apiClient opens api connection to the cluster.
+	apiClient *clients.Settings
+}
+
+// PullPreprovisioningImage pulls the existing preprovisioningimage of the bmh with the given name from the cluster.
+// The preprovisioningimage object is named after the bmh it belongs to.
+func PullPreprovisioningImage(apiClient *clients.Settings, name, nsname string) (*PreprovisioningImageBuilder, error) {
+	glog.V(100).Infof("Pulling existing preprovisioningimage name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient of the preprovisioningimage is nil")
+
+		return nil, fmt.Errorf("preprovisioningimage 'apiClient' cannot be nil")
+	}
+
+	builder := PreprovisioningImageBuilder{
+		apiClient: apiClient,
+		Definition: &bmhv1alpha1.PreprovisioningImage{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the preprovisioningimage is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "preprovisioningimage 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the preprovisioningimage is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "preprovisioningimage 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("preprovisioningimage object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WaitUntilReady waits for timeout duration or until the preprovisioningimage reports the Ready condition and an
+// image URL in its status. It returns early if the image generation reports an error.
+func (builder *PreprovisioningImageBuilder) WaitUntilReady(
+	timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for preprovisioningimage %s in namespace %s to be ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
+		image, err := builder.Get()
+		if err != nil {
+			return false, nil
+		}
+
+		builder.Object = image
+
+		errorCondition := meta.FindStatusCondition(image.Status.Conditions, string(bmhv1alpha1.ConditionImageError))
+		if errorCondition != nil && errorCondition.Status == metaV1.ConditionTrue {
+			return false, fmt.Errorf("preprovisioningimage %s in namespace %s failed: %s",
+				image.Name, image.Namespace, errorCondition.Message)
+		}
+
+		ready := meta.IsStatusConditionTrue(image.Status.Conditions, string(bmhv1alpha1.ConditionImageReady))
+		lastObserved = fmt.Sprintf("condition Ready %t, image URL %q", ready, image.Status.ImageUrl)
+
+		return ready && image.Status.ImageUrl != "", nil
+	}, options...)
+
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          bmhv1alpha1.GroupVersion.WithKind("PreprovisioningImage"),
+		Name:         builder.Definition.Name,
+		Namespace:    builder.Definition.Namespace,
+		Wanted:       "condition Ready true with image URL",
+		LastObserved: lastObserved,
+	})
+}
+
+// GetImageURL returns the URL of the generated image, which is an ISO or an initrd depending on the image format.
+func (builder *PreprovisioningImageBuilder) GetImageURL() (string, error) {
+	if err := builder.refresh(); err != nil {
+		return "", err
+	}
+
+	if builder.Object.Status.ImageUrl == "" {
+		return "", fmt.Errorf("preprovisioningimage %s in namespace %s has no image URL yet",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.ImageUrl, nil
+}
+
+// GetKernelURL returns the URL of the kernel to boot the generated initrd with. It is only set for the initrd
+// format.
+func (builder *PreprovisioningImageBuilder) GetKernelURL() (string, error) {
+	if err := builder.refresh(); err != nil {
+		return "", err
+	}
+
+	if builder.Object.Status.KernelUrl == "" {
+		return "", fmt.Errorf("preprovisioningimage %s in namespace %s has no kernel URL",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.KernelUrl, nil
+}
+
+// GetFormat returns the format of the generated image.
+func (builder *PreprovisioningImageBuilder) GetFormat() (bmhv1alpha1.ImageFormat, error) {
+	if err := builder.refresh(); err != nil {
+		return "", err
+	}
+
+	return builder.Object.Status.Format, nil
+}
+
+// GetExtraKernelParams returns the kernel parameters to pass when booting the generated image.
+func (builder *PreprovisioningImageBuilder) GetExtraKernelParams() (string, error) {
+	if err := builder.refresh(); err != nil {
+		return "", err
+	}
+
+	return builder.Object.Status.ExtraKernelParams, nil
+}
+
+// Exists checks whether the given preprovisioningimage exists.
+func (builder *PreprovisioningImageBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if preprovisioningimage %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil
+}
+
+// Get returns the preprovisioningimage object if found.
+func (builder *PreprovisioningImageBuilder) Get() (*bmhv1alpha1.PreprovisioningImage, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting preprovisioningimage %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	image := &bmhv1alpha1.PreprovisioningImage{}
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, image)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
+
+// refresh updates the builder object with the preprovisioningimage currently in the cluster.
+func (builder *PreprovisioningImageBuilder) refresh() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if !builder.Exists() {
+		return fmt.Errorf("preprovisioningimage object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PreprovisioningImageBuilder) validate() (bool, error) {
+	resourceCRD := "PreprovisioningImage"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, errors.New(builder.errorMsg)
+	}
+
+	return true, nil
+}
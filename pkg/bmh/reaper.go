@@ -0,0 +1,204 @@
+package bmh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TTLAnnotation holds the RFC3339 time after which a bmh and its BMC credentials secret may be removed
+// by ReapExpired.
+const TTLAnnotation = "eco-goinfra.openshift-kni.io/expires-at"
+
+// ReapExpired removes all baremetalhosts and secrets in the given namespace whose TTLAnnotation is in the past.
+// A secret is only removed once no remaining baremetalhost references it, so the BMC secret of a host that is still
+// being deprovisioned is kept and left for a later run. It returns the names of the removed baremetalhosts.
+func ReapExpired(apiClient *clients.Settings, nsname string) ([]string, error) {
+	return ReapExpiredWithContext(context.TODO(), apiClient, nsname)
+}
+
+// ReapExpiredWithContext behaves like ReapExpired and cancels its requests when ctx is done.
+func ReapExpiredWithContext(ctx context.Context, apiClient *clients.Settings, nsname string) ([]string, error) {
+	glog.V(100).Infof("Removing expired baremetalhosts in namespace %s", nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to remove expired baremetalhosts, 'apiClient' parameter is nil")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace is empty")
+
+		return nil, fmt.Errorf("failed to remove expired baremetalhosts, 'nsname' parameter is empty")
+	}
+
+	reapedHosts, reapErrors, err := reapExpiredHosts(ctx, apiClient, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	// The hosts are listed again since the deleted ones are only gone once metal3 has deprovisioned them and
+	// removed their finalizers, until then they still use their BMC secret.
+	referencedSecrets, err := listReferencedSecrets(ctx, apiClient, nsname)
+	if err != nil {
+		return reapedHosts, utilerrors.NewAggregate(append(reapErrors, err))
+	}
+
+	secretList := &corev1.SecretList{}
+
+	err = apiClient.List(ctx, secretList, &goclient.ListOptions{Namespace: nsname})
+	if err != nil {
+		glog.V(100).Infof("Failed to list secrets in namespace %s due to %s", nsname, err.Error())
+
+		return reapedHosts, utilerrors.NewAggregate(append(reapErrors, err))
+	}
+
+	for index := range secretList.Items {
+		secret := &secretList.Items[index]
+		if !isExpired(secret.ObjectMeta) {
+			continue
+		}
+
+		if referencedSecrets[secret.Name] {
+			glog.V(100).Infof("Keeping expired secret %s in namespace %s until no baremetalhost references it",
+				secret.Name, nsname)
+
+			continue
+		}
+
+		glog.V(100).Infof("Removing expired secret %s in namespace %s", secret.Name, nsname)
+
+		err := apiClient.Delete(ctx, secret)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			reapErrors = append(reapErrors, fmt.Errorf("failed to remove secret %s: %w", secret.Name, err))
+		}
+	}
+
+	return reapedHosts, utilerrors.NewAggregate(reapErrors)
+}
+
+// reapExpiredHosts deletes the expired bmhs in the given namespace. It returns the names of the deleted bmhs, the
+// errors of the failed deletions and the error of the list request.
+func reapExpiredHosts(ctx context.Context, apiClient *clients.Settings, nsname string) ([]string, []error, error) {
+	bmhList := &bmhv1alpha1.BareMetalHostList{}
+
+	err := apiClient.List(ctx, bmhList, &goclient.ListOptions{Namespace: nsname})
+	if err != nil {
+		glog.V(100).Infof("Failed to list baremetalhosts in namespace %s due to %s", nsname, err.Error())
+
+		return nil, nil, err
+	}
+
+	var (
+		reapedHosts []string
+		reapErrors  []error
+	)
+
+	for index := range bmhList.Items {
+		bareMetalHost := &bmhList.Items[index]
+
+		if !isExpired(bareMetalHost.ObjectMeta) {
+			continue
+		}
+
+		glog.V(100).Infof("Removing expired baremetalhost %s in namespace %s", bareMetalHost.Name, nsname)
+
+		if err := apiClient.Delete(ctx, bareMetalHost); err != nil && !k8serrors.IsNotFound(err) {
+			reapErrors = append(reapErrors, fmt.Errorf("failed to remove baremetalhost %s: %w", bareMetalHost.Name, err))
+
+			continue
+		}
+
+		reapedHosts = append(reapedHosts, bareMetalHost.Name)
+	}
+
+	return reapedHosts, reapErrors, nil
+}
+
+// listReferencedSecrets returns the names of the BMC secrets used by the bmhs currently in the given namespace,
+// including the ones being deleted.
+func listReferencedSecrets(ctx context.Context, apiClient *clients.Settings, nsname string) (map[string]bool, error) {
+	bmhList := &bmhv1alpha1.BareMetalHostList{}
+
+	err := apiClient.List(ctx, bmhList, &goclient.ListOptions{Namespace: nsname})
+	if err != nil {
+		glog.V(100).Infof("Failed to list baremetalhosts in namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	referencedSecrets := make(map[string]bool)
+
+	for _, bareMetalHost := range bmhList.Items {
+		if bareMetalHost.Spec.BMC.CredentialsName != "" {
+			referencedSecrets[bareMetalHost.Spec.BMC.CredentialsName] = true
+		}
+	}
+
+	return referencedSecrets, nil
+}
+
+// annotateCredentialsSecretExpiry copies the TTLAnnotation of the bmh to the BMC secret owned by the builder. Secrets
+// the builder did not create are left untouched, they may be shared or managed outside of the test. Failures are only
+// logged.
+func (builder *BmhBuilder) annotateCredentialsSecretExpiry(ctx context.Context) {
+	expiry, ok := builder.Definition.Annotations[TTLAnnotation]
+	if !ok || builder.credentialsSecret == nil {
+		return
+	}
+
+	secret := &corev1.Secret{}
+
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
+		Name:      builder.credentialsSecret.Definition.Name,
+		Namespace: builder.credentialsSecret.Definition.Namespace,
+	}, secret)
+	if err != nil {
+		glog.V(100).Infof("Failed to get baremetalhost %s credentials secret due to %s",
+			builder.ObjectName(), err.Error())
+
+		return
+	}
+
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+
+	secret.Annotations[TTLAnnotation] = expiry
+
+	if err := builder.apiClient.Update(ctx, secret); err != nil {
+		glog.V(100).Infof("Failed to annotate baremetalhost %s credentials secret due to %s",
+			builder.ObjectName(), err.Error())
+
+		return
+	}
+
+	builder.credentialsSecret.Object = secret
+}
+
+// isExpired returns true when the object carries a TTLAnnotation in the past.
+func isExpired(objectMeta metaV1.ObjectMeta) bool {
+	expiry, ok := objectMeta.Annotations[TTLAnnotation]
+	if !ok {
+		return false
+	}
+
+	expiryTime, err := time.Parse(time.RFC3339, expiry)
+	if err != nil {
+		glog.V(100).Infof("Ignoring malformed %s annotation on %s: %s", TTLAnnotation, objectMeta.Name, expiry)
+
+		return false
+	}
+
+	return time.Now().After(expiryTime)
+}
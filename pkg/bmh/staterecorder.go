@@ -0,0 +1,208 @@
+package bmh
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StateTransition is a state the bmh was observed in by a StateRecorder, together with how long it stayed in it.
+type StateTransition struct {
+	ProvisioningState bmhv1alpha1.ProvisioningState
+	OperationalStatus bmhv1alpha1.OperationalStatus
+	ErrorType         bmhv1alpha1.ErrorType
+	// Start is the time of the first sample in this state.
+	Start time.Time
+	// Duration is the time between Start and the first sample in the next state, or the last sample if the bmh
+	// did not leave the state while recording.
+	Duration time.Duration
+}
+
+// String returns the state together with its duration, e.g. "provisioning/OK (2m30s)".
+func (transition StateTransition) String() string {
+	state := fmt.Sprintf("%s/%s", transition.ProvisioningState, transition.OperationalStatus)
+	if transition.ErrorType != "" {
+		state = fmt.Sprintf("%s/%s", state, transition.ErrorType)
+	}
+
+	return fmt.Sprintf("%s (%s)", state, transition.Duration.Round(time.Second))
+}
+
+// StateHistory is the ordered list of states a bmh was observed in.
+type StateHistory []StateTransition
+
+// String returns the states in the order they were observed, separated by arrows.
+func (history StateHistory) String() string {
+	states := make([]string, 0, len(history))
+
+	for _, transition := range history {
+		states = append(states, transition.String())
+	}
+
+	return strings.Join(states, " -> ")
+}
+
+// StateRecorder periodically samples the provisioning state, operational status and error type of a bmh and
+// records every transition between them. It helps diagnosing hosts that bounce between states during a wait.
+type StateRecorder struct {
+	builder  *BmhBuilder
+	interval time.Duration
+	history  StateHistory
+	mutex    sync.Mutex
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewStateRecorder creates a new instance of StateRecorder sampling the bmh of the given builder every interval.
+func NewStateRecorder(builder *BmhBuilder, interval time.Duration) (*StateRecorder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Initializing new state recorder for baremetalhost %s in namespace %s with interval %s",
+		builder.ObjectName(), builder.ObjectNamespace(), interval)
+
+	if interval <= 0 {
+		glog.V(100).Infof("The sampling interval is not positive")
+
+		return nil, fmt.Errorf("failed to initialize state recorder, 'interval' must be greater than 0")
+	}
+
+	// The recorder samples through its own builder so that it does not race with the waits of the given one.
+	samplingBuilder := &BmhBuilder{
+		apiClient: builder.apiClient,
+		Definition: &bmhv1alpha1.BareMetalHost{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      builder.ObjectName(),
+				Namespace: builder.ObjectNamespace(),
+			},
+		},
+	}
+
+	return &StateRecorder{builder: samplingBuilder, interval: interval}, nil
+}
+
+// Start takes a first sample and keeps sampling in the background until Stop is called.
+func (recorder *StateRecorder) Start() error {
+	if recorder == nil {
+		return fmt.Errorf("error: received nil state recorder")
+	}
+
+	if recorder.stop != nil {
+		return fmt.Errorf("state recorder is already running")
+	}
+
+	recorder.sample()
+
+	recorder.stop = make(chan struct{})
+	recorder.done = make(chan struct{})
+
+	go func() {
+		defer close(recorder.done)
+
+		ticker := time.NewTicker(recorder.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-recorder.stop:
+				return
+			case <-ticker.C:
+				recorder.sample()
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop takes a last sample, ends the sampling and returns the recorded history.
+func (recorder *StateRecorder) Stop() StateHistory {
+	if recorder == nil {
+		return nil
+	}
+
+	if recorder.stop != nil {
+		close(recorder.stop)
+		<-recorder.done
+
+		recorder.stop = nil
+
+		recorder.sample()
+	}
+
+	return recorder.History()
+}
+
+// History returns a copy of the history recorded so far.
+func (recorder *StateRecorder) History() StateHistory {
+	if recorder == nil {
+		return nil
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	return append(StateHistory{}, recorder.history...)
+}
+
+// Record runs waitFunc, e.g. a closure around bmhBuilder.WaitUntilProvisioned, while sampling the bmh state. It
+// returns the recorded history and the error of waitFunc, annotated with the history if not nil.
+func (recorder *StateRecorder) Record(waitFunc func() error) (StateHistory, error) {
+	if waitFunc == nil {
+		glog.V(100).Infof("The wait function is nil")
+
+		return nil, fmt.Errorf("wait function cannot be nil")
+	}
+
+	if err := recorder.Start(); err != nil {
+		return nil, err
+	}
+
+	err := waitFunc()
+	history := recorder.Stop()
+
+	if err != nil {
+		return history, fmt.Errorf("%w, state history: %s", err, history)
+	}
+
+	return history, nil
+}
+
+// sample records the current state of the bmh, starting a new transition if it changed. Failed reads are skipped.
+func (recorder *StateRecorder) sample() {
+	bmh, err := recorder.builder.Get()
+	if err != nil {
+		glog.V(100).Infof("Failed to sample baremetalhost state: %s", err.Error())
+
+		return
+	}
+
+	now := time.Now()
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	if count := len(recorder.history); count > 0 {
+		last := &recorder.history[count-1]
+		last.Duration = now.Sub(last.Start)
+
+		if last.ProvisioningState == bmh.Status.Provisioning.State &&
+			last.OperationalStatus == bmh.Status.OperationalStatus &&
+			last.ErrorType == bmh.Status.ErrorType {
+			return
+		}
+	}
+
+	recorder.history = append(recorder.history, StateTransition{
+		ProvisioningState: bmh.Status.Provisioning.State,
+		OperationalStatus: bmh.Status.OperationalStatus,
+		ErrorType:         bmh.Status.ErrorType,
+		Start:             now,
+	})
+}
@@ -0,0 +1,75 @@
+package bmh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+)
+
+// WaitUntilOperationalStatusOK waits for timeout duration or until the bmh reports the OK operational status.
+func (builder *BmhBuilder) WaitUntilOperationalStatusOK(timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for baremetalhost %s in namespace %s to report operational status OK",
+		builder.ObjectName(), builder.ObjectNamespace())
+
+	var lastObserved string
+
+	err := await.PollWithContext(timeout, func(ctx context.Context) (bool, error) {
+		bmh, err := builder.GetWithContext(ctx)
+		if err != nil {
+			return false, nil
+		}
+
+		builder.Object = bmh
+		lastObserved = fmt.Sprintf("operational status %q, error type %q", bmh.Status.OperationalStatus,
+			bmh.Status.ErrorType)
+
+		return bmh.Status.OperationalStatus == bmhv1alpha1.OperationalStatusOK, nil
+	}, options...)
+
+	return builder.withTimeoutDetails(
+		err, fmt.Sprintf("operational status %q", bmhv1alpha1.OperationalStatusOK), lastObserved)
+}
+
+// IsInErrorState checks whether the bmh reports the error operational status.
+func (builder *BmhBuilder) IsInErrorState() bool {
+	if !builder.Exists() || builder.Object == nil {
+		return false
+	}
+
+	return builder.Object.Status.OperationalStatus == bmhv1alpha1.OperationalStatusError
+}
+
+// GetErrorType returns the type of the last error the bmh reports, e.g. registration error. It is empty if the
+// bmh has no error.
+func (builder *BmhBuilder) GetErrorType() (bmhv1alpha1.ErrorType, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	if !builder.Exists() || builder.Object == nil {
+		return "", fmt.Errorf("bmh %s in namespace %s does not exist", builder.ObjectName(), builder.ObjectNamespace())
+	}
+
+	return builder.Object.Status.ErrorType, nil
+}
+
+// GetErrorMessage returns the message of the last error the bmh reports. It is empty if the bmh has no error.
+func (builder *BmhBuilder) GetErrorMessage() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	if !builder.Exists() || builder.Object == nil {
+		return "", fmt.Errorf("bmh %s in namespace %s does not exist", builder.ObjectName(), builder.ObjectNamespace())
+	}
+
+	return builder.Object.Status.ErrorMessage, nil
+}
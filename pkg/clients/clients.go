@@ -82,6 +82,9 @@ type Settings struct {
 	olmv1.OperatorsV1Interface
 	PackageManifestInterface clientPkgManifestV1.OperatorsV1Interface
 	operatorv1alpha1.OperatorV1alpha1Interface
+	hooks       hookRegistry
+	namespaces  namespaceOverrides
+	callTimeout callTimeout
 }
 
 // New returns a *Settings with the given kubeconfig.
@@ -108,46 +111,63 @@ func New(kubeconfig string) *Settings {
 	}
 
 	clientSet := &Settings{}
-	clientSet.CoreV1Interface = coreV1Client.NewForConfigOrDie(config)
-	clientSet.ConfigV1Interface = clientConfigV1.NewForConfigOrDie(config)
-	clientSet.MachineconfigurationV1Interface = clientMachineConfigV1.NewForConfigOrDie(config)
-	clientSet.AppsV1Interface = appsV1Client.NewForConfigOrDie(config)
-	clientSet.SriovnetworkV1Interface = clientSrIovV1.NewForConfigOrDie(config)
-	clientSet.NetworkingV1Client = *networkV1Client.NewForConfigOrDie(config)
-	clientSet.PtpV1Interface = ptpV1.NewForConfigOrDie(config)
-	clientSet.RbacV1Interface = rbacV1Client.NewForConfigOrDie(config)
-	clientSet.OperatorsV1alpha1Interface = olm.NewForConfigOrDie(config)
-	clientSet.K8sCniCncfIoV1Interface = clientNetAttDefV1.NewForConfigOrDie(config)
-	clientSet.Interface = dynamic.NewForConfigOrDie(config)
-	clientSet.OperatorsV1Interface = olmv1.NewForConfigOrDie(config)
-	clientSet.PackageManifestInterface = clientPkgManifestV1.NewForConfigOrDie(config)
-	clientSet.SecurityV1Interface = v1security.NewForConfigOrDie(config)
-	clientSet.ArgoprojV1alpha1Interface = argocdClient.NewForConfigOrDie(config)
-	clientSet.OperatorV1alpha1Interface = operatorv1alpha1.NewForConfigOrDie(config)
+	clientSet.callTimeout.set(DefaultCallTimeout)
+
+	// The typed clientsets bound their requests in their transport, the dynamic and controller-runtime clients are
+	// wrapped instead.
+	typedConfig := withCallTimeout(config, &clientSet.callTimeout)
+
+	clientSet.CoreV1Interface = coreV1Client.NewForConfigOrDie(typedConfig)
+	clientSet.ConfigV1Interface = clientConfigV1.NewForConfigOrDie(typedConfig)
+	clientSet.MachineconfigurationV1Interface = clientMachineConfigV1.NewForConfigOrDie(typedConfig)
+	clientSet.AppsV1Interface = appsV1Client.NewForConfigOrDie(typedConfig)
+	clientSet.SriovnetworkV1Interface = clientSrIovV1.NewForConfigOrDie(typedConfig)
+	clientSet.NetworkingV1Client = *networkV1Client.NewForConfigOrDie(typedConfig)
+	clientSet.PtpV1Interface = ptpV1.NewForConfigOrDie(typedConfig)
+	clientSet.RbacV1Interface = rbacV1Client.NewForConfigOrDie(typedConfig)
+	clientSet.OperatorsV1alpha1Interface = olm.NewForConfigOrDie(typedConfig)
+	clientSet.K8sCniCncfIoV1Interface = clientNetAttDefV1.NewForConfigOrDie(typedConfig)
+	clientSet.Interface = newTimeoutDynamicClient(dynamic.NewForConfigOrDie(config), &clientSet.callTimeout)
+	clientSet.OperatorsV1Interface = olmv1.NewForConfigOrDie(typedConfig)
+	clientSet.PackageManifestInterface = clientPkgManifestV1.NewForConfigOrDie(typedConfig)
+	clientSet.SecurityV1Interface = v1security.NewForConfigOrDie(typedConfig)
+	clientSet.ArgoprojV1alpha1Interface = argocdClient.NewForConfigOrDie(typedConfig)
+	clientSet.OperatorV1alpha1Interface = operatorv1alpha1.NewForConfigOrDie(typedConfig)
 	clientSet.Config = config
 
+	clientSet.Client, err = newRuntimeClient(config, &clientSet.callTimeout, &clientSet.hooks)
+	if err != nil {
+		return nil
+	}
+
+	clientSet.KubeconfigPath = kubeconfig
+
+	return clientSet
+}
+
+// newRuntimeClient returns a controller-runtime client with the scheme of SetScheme, applying the given call timeout
+// to every call without deadline and running the given hooks after its writes.
+func newRuntimeClient(config *rest.Config, timeout *callTimeout, hooks *hookRegistry) (runtimeClient.Client, error) {
 	crScheme := runtime.NewScheme()
-	err = SetScheme(crScheme)
+	err := SetScheme(crScheme)
 
 	if err != nil {
 		log.Print("Error to load apiClient scheme")
 
-		return nil
+		return nil, err
 	}
 
-	clientSet.Client, err = runtimeClient.New(config, runtimeClient.Options{
+	client, err := runtimeClient.New(config, runtimeClient.Options{
 		Scheme: crScheme,
 	})
 
 	if err != nil {
 		log.Print("Error to create apiClient")
 
-		return nil
+		return nil, err
 	}
 
-	clientSet.KubeconfigPath = kubeconfig
-
-	return clientSet
+	return newTimeoutClient(client, timeout, hooks), nil
 }
 
 // SetScheme returns mutated apiClient's scheme.
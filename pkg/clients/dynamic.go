@@ -0,0 +1,142 @@
+package clients
+
+import (
+	"context"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// timeoutDynamicClient is a dynamic client applying the call timeout of Settings to every request whose context has
+// no deadline. Watch requests are long-lived and are not bounded.
+type timeoutDynamicClient struct {
+	dynamic.Interface
+	timeout *callTimeout
+}
+
+// newTimeoutDynamicClient wraps client with a timeoutDynamicClient using the given call timeout.
+func newTimeoutDynamicClient(client dynamic.Interface, timeout *callTimeout) *timeoutDynamicClient {
+	return &timeoutDynamicClient{Interface: client, timeout: timeout}
+}
+
+// Resource returns a client for the given resource.
+func (client *timeoutDynamicClient) Resource(
+	resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	namespaceable := client.Interface.Resource(resource)
+
+	return &timeoutNamespaceableResource{
+		timeoutResource: timeoutResource{ResourceInterface: namespaceable, timeout: client.timeout},
+		namespaceable:   namespaceable,
+	}
+}
+
+// timeoutNamespaceableResource is a dynamic resource client of cluster scoped objects, or of objects in all
+// namespaces, applying the call timeout of Settings.
+type timeoutNamespaceableResource struct {
+	timeoutResource
+	namespaceable dynamic.NamespaceableResourceInterface
+}
+
+// Namespace returns a client for the resource in the given namespace.
+func (client *timeoutNamespaceableResource) Namespace(nsname string) dynamic.ResourceInterface {
+	return &timeoutResource{ResourceInterface: client.namespaceable.Namespace(nsname), timeout: client.timeout}
+}
+
+// timeoutResource is a dynamic resource client applying the call timeout of Settings to every request but Watch.
+type timeoutResource struct {
+	dynamic.ResourceInterface
+	timeout *callTimeout
+}
+
+// Create creates the given object.
+func (client *timeoutResource) Create(ctx context.Context, obj *unstructured.Unstructured,
+	options metaV1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.ResourceInterface.Create(ctx, obj, options, subresources...)
+}
+
+// Update updates the given object.
+func (client *timeoutResource) Update(ctx context.Context, obj *unstructured.Unstructured,
+	options metaV1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.ResourceInterface.Update(ctx, obj, options, subresources...)
+}
+
+// UpdateStatus updates the status of the given object.
+func (client *timeoutResource) UpdateStatus(ctx context.Context,
+	obj *unstructured.Unstructured, options metaV1.UpdateOptions) (*unstructured.Unstructured, error) {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.ResourceInterface.UpdateStatus(ctx, obj, options)
+}
+
+// Delete deletes the named object.
+func (client *timeoutResource) Delete(
+	ctx context.Context, name string, options metaV1.DeleteOptions, subresources ...string) error {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.ResourceInterface.Delete(ctx, name, options, subresources...)
+}
+
+// DeleteCollection deletes the objects matching the given list options.
+func (client *timeoutResource) DeleteCollection(
+	ctx context.Context, options metaV1.DeleteOptions, listOptions metaV1.ListOptions) error {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.ResourceInterface.DeleteCollection(ctx, options, listOptions)
+}
+
+// Get retrieves the named object.
+func (client *timeoutResource) Get(ctx context.Context,
+	name string, options metaV1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.ResourceInterface.Get(ctx, name, options, subresources...)
+}
+
+// List retrieves the objects matching the given list options.
+func (client *timeoutResource) List(
+	ctx context.Context, options metaV1.ListOptions) (*unstructured.UnstructuredList, error) {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.ResourceInterface.List(ctx, options)
+}
+
+// Patch patches the named object.
+func (client *timeoutResource) Patch(ctx context.Context, name string, patchType types.PatchType, data []byte,
+	options metaV1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.ResourceInterface.Patch(ctx, name, patchType, data, options, subresources...)
+}
+
+// Apply applies the given object with server-side apply.
+func (client *timeoutResource) Apply(ctx context.Context, name string, obj *unstructured.Unstructured,
+	options metaV1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.ResourceInterface.Apply(ctx, name, obj, options, subresources...)
+}
+
+// ApplyStatus applies the status of the given object with server-side apply.
+func (client *timeoutResource) ApplyStatus(ctx context.Context,
+	name string, obj *unstructured.Unstructured, options metaV1.ApplyOptions) (*unstructured.Unstructured, error) {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.ResourceInterface.ApplyStatus(ctx, name, obj, options)
+}
@@ -0,0 +1,139 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// HealthReport contains the result of the Settings readiness probes.
+type HealthReport struct {
+	// ServerVersion is the git version reported by the API server. Empty if the version probe failed.
+	ServerVersion string
+	// VersionError is the error returned by the version probe.
+	VersionError error
+	// CanListNamespaces reports whether the configured user is allowed to list namespaces.
+	CanListNamespaces bool
+	// NamespaceListError is the error returned by the namespace list probe.
+	NamespaceListError error
+	// GroupVersions reports for every requested group version whether it is served by the cluster.
+	GroupVersions map[string]bool
+	// GroupVersionErrors contains the errors other than not found returned while probing group versions.
+	GroupVersionErrors map[string]error
+}
+
+// Ready returns true when all probes of the report succeeded.
+func (report *HealthReport) Ready() bool {
+	if report == nil || report.VersionError != nil || !report.CanListNamespaces {
+		return false
+	}
+
+	for _, served := range report.GroupVersions {
+		if !served {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns a human readable summary of the failed probes.
+func (report *HealthReport) String() string {
+	if report == nil {
+		return "health report is nil"
+	}
+
+	if report.Ready() {
+		return fmt.Sprintf("cluster is ready, server version %s", report.ServerVersion)
+	}
+
+	var reasons []string
+
+	if report.VersionError != nil {
+		reasons = append(reasons, fmt.Sprintf("version probe failed: %s", report.VersionError))
+	}
+
+	if !report.CanListNamespaces {
+		reasons = append(reasons, fmt.Sprintf("cannot list namespaces: %s", report.NamespaceListError))
+	}
+
+	for groupVersion, served := range report.GroupVersions {
+		if served {
+			continue
+		}
+
+		if err, ok := report.GroupVersionErrors[groupVersion]; ok {
+			reasons = append(reasons, fmt.Sprintf("failed to probe %s: %s", groupVersion, err))
+
+			continue
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s is not served by the cluster", groupVersion))
+	}
+
+	return fmt.Sprintf("cluster is not ready: %s", strings.Join(reasons, "; "))
+}
+
+// Validate probes the API server version, checks that namespaces can be listed and verifies that the given
+// group versions are served, e.g. metal3.io/v1alpha1 for the bmh package. The returned error is only set when
+// the probes could not be run at all, failed probes are reported in the HealthReport.
+func (settings *Settings) Validate(groupVersions ...schema.GroupVersion) (*HealthReport, error) {
+	if settings == nil {
+		glog.V(100).Infof("APIClient is nil")
+
+		return nil, fmt.Errorf("APIClient cannot be nil")
+	}
+
+	if settings.Config == nil {
+		glog.V(100).Infof("APIClient rest config is nil")
+
+		return nil, fmt.Errorf("APIClient rest config cannot be nil")
+	}
+
+	glog.V(100).Infof("Validating APIClient against group versions %v", groupVersions)
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(settings.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	report := &HealthReport{
+		GroupVersions:      make(map[string]bool),
+		GroupVersionErrors: make(map[string]error),
+	}
+
+	serverVersion, err := discoveryClient.ServerVersion()
+	if err != nil {
+		glog.V(100).Infof("Failed to get server version due to %s", err.Error())
+
+		report.VersionError = err
+	} else {
+		report.ServerVersion = serverVersion.GitVersion
+	}
+
+	if settings.CoreV1Interface != nil {
+		_, err = settings.Namespaces().List(context.TODO(), metaV1.ListOptions{Limit: 1})
+	} else {
+		err = fmt.Errorf("APIClient has no CoreV1 client")
+	}
+
+	report.CanListNamespaces = err == nil
+	report.NamespaceListError = err
+
+	for _, groupVersion := range groupVersions {
+		_, err := discoveryClient.ServerResourcesForGroupVersion(groupVersion.String())
+		report.GroupVersions[groupVersion.String()] = err == nil
+
+		if err != nil && !k8serrors.IsNotFound(err) {
+			report.GroupVersionErrors[groupVersion.String()] = err
+		}
+	}
+
+	return report, nil
+}
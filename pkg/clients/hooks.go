@@ -0,0 +1,99 @@
+package clients
+
+import (
+	"sync"
+
+	"github.com/golang/glog"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HookEvent is the kind of operation a Hook is invoked for.
+type HookEvent string
+
+const (
+	// HookEventCreate is passed to the hooks invoked after an object was created.
+	HookEventCreate HookEvent = "Create"
+	// HookEventUpdate is passed to the hooks invoked after an object was updated or patched.
+	HookEventUpdate HookEvent = "Update"
+	// HookEventDelete is passed to the hooks invoked after an object was deleted.
+	HookEventDelete HookEvent = "Delete"
+)
+
+// Hook is a callback invoked after a successful operation on the given object. Hooks must not modify the object.
+type Hook func(event HookEvent, object runtimeClient.Object)
+
+// hookRegistry stores the hooks registered on Settings per event. Its zero value is ready to use.
+type hookRegistry struct {
+	mutex sync.RWMutex
+	hooks map[HookEvent][]Hook
+}
+
+// OnCreate registers a hook invoked after an object was created through the controller-runtime client of these
+// Settings or by the Create method of a builder using the typed clientsets or the dynamic client, e.g. the pod or
+// consoleplugin builders.
+func (settings *Settings) OnCreate(hook Hook) {
+	settings.registerHook(HookEventCreate, hook)
+}
+
+// OnUpdate registers a hook invoked after an object was updated or patched through the controller-runtime client of
+// these Settings or by the Update method of a builder using the typed clientsets or the dynamic client. Status and
+// other subresource writes are not covered.
+func (settings *Settings) OnUpdate(hook Hook) {
+	settings.registerHook(HookEventUpdate, hook)
+}
+
+// OnDelete registers a hook invoked after an object was deleted through the controller-runtime client of these
+// Settings or by the Delete method of a builder using the typed clientsets or the dynamic client. Objects deleted
+// with DeleteAllOf or DeleteCollection are not covered.
+func (settings *Settings) OnDelete(hook Hook) {
+	settings.registerHook(HookEventDelete, hook)
+}
+
+// RunHooks invokes the hooks registered for the given event in the order they were registered. The controller-runtime
+// client of Settings runs them automatically, the builders using the typed clientsets or the dynamic client call
+// RunHooks after their successful Create, Update and Delete calls. It does nothing if no hooks are registered.
+func (settings *Settings) RunHooks(event HookEvent, object runtimeClient.Object) {
+	if settings == nil {
+		return
+	}
+
+	settings.hooks.run(event, object)
+}
+
+// registerHook adds hook to the hooks of the given event.
+func (settings *Settings) registerHook(event HookEvent, hook Hook) {
+	if settings == nil || hook == nil {
+		glog.V(100).Infof("Cannot register %s hook on nil settings or with nil hook", event)
+
+		return
+	}
+
+	settings.hooks.register(event, hook)
+}
+
+// register adds hook to the hooks of the given event.
+func (registry *hookRegistry) register(event HookEvent, hook Hook) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if registry.hooks == nil {
+		registry.hooks = make(map[HookEvent][]Hook)
+	}
+
+	registry.hooks[event] = append(registry.hooks[event], hook)
+}
+
+// run invokes the hooks registered for the given event. It does nothing on a nil registry or object.
+func (registry *hookRegistry) run(event HookEvent, object runtimeClient.Object) {
+	if registry == nil || object == nil {
+		return
+	}
+
+	registry.mutex.RLock()
+	hooks := registry.hooks[event]
+	registry.mutex.RUnlock()
+
+	for _, hook := range hooks {
+		hook(event, object)
+	}
+}
@@ -0,0 +1,57 @@
+package clients
+
+import (
+	"sync"
+
+	"github.com/golang/glog"
+)
+
+// namespaceOverrides stores the operator namespaces overridden on Settings per component.
+type namespaceOverrides struct {
+	mutex      sync.RWMutex
+	namespaces map[string]string
+}
+
+// OverrideNamespace sets the namespace used for the given component, e.g. "sriov" or "metallb", instead of the
+// DefaultNamespace of its package. It allows running against deployments where the operator is installed in a
+// different namespace than the productized default. An empty nsname removes the override.
+func (settings *Settings) OverrideNamespace(component, nsname string) {
+	if settings == nil || component == "" {
+		glog.V(100).Infof("Cannot override namespace on nil settings or for empty component")
+
+		return
+	}
+
+	glog.V(100).Infof("Overriding namespace of component %s with %q", component, nsname)
+
+	settings.namespaces.mutex.Lock()
+	defer settings.namespaces.mutex.Unlock()
+
+	if settings.namespaces.namespaces == nil {
+		settings.namespaces.namespaces = make(map[string]string)
+	}
+
+	if nsname == "" {
+		delete(settings.namespaces.namespaces, component)
+
+		return
+	}
+
+	settings.namespaces.namespaces[component] = nsname
+}
+
+// Namespace returns the namespace overridden for the given component, or defaultNsName if there is no override.
+func (settings *Settings) Namespace(component, defaultNsName string) string {
+	if settings == nil {
+		return defaultNsName
+	}
+
+	settings.namespaces.mutex.RLock()
+	defer settings.namespaces.mutex.RUnlock()
+
+	if nsname, ok := settings.namespaces.namespaces[component]; ok {
+		return nsname
+	}
+
+	return defaultNsName
+}
@@ -0,0 +1,157 @@
+package clients
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StepOutcome is the outcome of a step reported to a StepReporter.
+type StepOutcome string
+
+const (
+	// StepSucceeded is the outcome of a step that returned no error.
+	StepSucceeded StepOutcome = "Succeeded"
+	// StepFailed is the outcome of a step that returned an error.
+	StepFailed StepOutcome = "Failed"
+)
+
+// StepRecord describes a single operation performed on an object, e.g. a Create call or a wait of a builder.
+type StepRecord struct {
+	Action    string        `json:"action"`
+	Kind      string        `json:"kind"`
+	Name      string        `json:"name,omitempty"`
+	Namespace string        `json:"namespace,omitempty"`
+	Start     time.Time     `json:"start"`
+	Duration  time.Duration `json:"duration"`
+	Outcome   StepOutcome   `json:"outcome"`
+	// Message is the error of a failed step.
+	Message string `json:"message,omitempty"`
+}
+
+// StepReporter receives the steps performed through Settings. The Create, Update, Patch, Delete and DeleteAllOf
+// calls of the controller-runtime client, the writes of its Status and SubResource clients and every wait run by
+// await.Poll are reported automatically. Writes sent through the typed clientsets or the dynamic client of Settings
+// are not reported. Implementations must be safe for concurrent use.
+type StepReporter interface {
+	ReportStep(record StepRecord)
+}
+
+// stepReporterHolder stores the StepReporter of a client.
+type stepReporterHolder struct {
+	mutex    sync.RWMutex
+	reporter StepReporter
+}
+
+// SetStepReporter sets the reporter receiving the steps performed through these Settings. A nil reporter disables
+// reporting. It does nothing if the client was not created by New. The waits are not tied to a client, they are
+// reported to the reporter of the Settings whose SetStepReporter was called last.
+func (settings *Settings) SetStepReporter(reporter StepReporter) {
+	if settings == nil {
+		glog.V(100).Infof("Cannot set step reporter on nil settings")
+
+		return
+	}
+
+	client, ok := settings.Client.(*timeoutClient)
+	if !ok {
+		glog.V(100).Infof("The client of the settings does not support step reporting")
+
+		return
+	}
+
+	client.steps.mutex.Lock()
+	client.steps.reporter = reporter
+	client.steps.mutex.Unlock()
+
+	if reporter == nil {
+		await.SetWaitReporter(nil)
+
+		return
+	}
+
+	await.SetWaitReporter(func(record await.WaitRecord) {
+		reporter.ReportStep(newWaitStepRecord(record))
+	})
+}
+
+// ReportStep reports the given action on object, started at start and ending now with err, to the StepReporter of
+// the settings. It is meant for operations that are neither a single client call nor a wait, and does nothing if no
+// reporter is set.
+func (settings *Settings) ReportStep(action string, object runtimeClient.Object, start time.Time, err error) {
+	if settings == nil {
+		return
+	}
+
+	if client, ok := settings.Client.(*timeoutClient); ok {
+		client.report(action, object, start, err)
+	}
+}
+
+// report sends a StepRecord built from the given operation to the step reporter, if any.
+func (client *timeoutClient) report(action string, object runtimeClient.Object, start time.Time, err error) {
+	client.steps.mutex.RLock()
+	reporter := client.steps.reporter
+	client.steps.mutex.RUnlock()
+
+	if reporter == nil || object == nil {
+		return
+	}
+
+	record := StepRecord{
+		Action:    action,
+		Kind:      object.GetObjectKind().GroupVersionKind().Kind,
+		Name:      object.GetName(),
+		Namespace: object.GetNamespace(),
+		Start:     start,
+		Duration:  time.Since(start),
+		Outcome:   StepSucceeded,
+	}
+
+	if gvk, gvkErr := apiutil.GVKForObject(object, client.Scheme()); gvkErr == nil {
+		record.Kind = gvk.Kind
+	}
+
+	if err != nil {
+		record.Outcome = StepFailed
+		record.Message = err.Error()
+	}
+
+	reporter.ReportStep(record)
+}
+
+// newWaitStepRecord converts a wait reported by await.Poll into a StepRecord. The action is the name of the function
+// that waited, followed by the description of the wait if any, and the kind is its package and receiver, e.g.
+// "WaitUntilInStatus" and "pod.Builder" for pod.(*Builder).WaitUntilInStatus.
+func newWaitStepRecord(record await.WaitRecord) StepRecord {
+	kind, action := record.Caller, record.Caller
+	if index := strings.LastIndex(record.Caller, "."); index != -1 {
+		kind, action = record.Caller[:index], record.Caller[index+1:]
+	}
+
+	if record.Description != "" {
+		action = fmt.Sprintf("%s %s", action, record.Description)
+	}
+
+	step := StepRecord{
+		Action:   action,
+		Kind:     strings.NewReplacer("(*", "", ")", "").Replace(kind),
+		Start:    record.Start,
+		Duration: record.Duration,
+		Outcome:  StepSucceeded,
+	}
+
+	if record.Err != nil {
+		step.Outcome = StepFailed
+		step.Message = record.Err.Error()
+	}
+
+	return step
+}
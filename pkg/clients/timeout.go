@@ -0,0 +1,252 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultCallTimeout bounds every request sent through the controller-runtime client, the dynamic client and the
+// typed clientsets of Settings whose context has no deadline, so that an unreachable API server results in an error
+// instead of a hanging call. Watches, followed logs, exec and port-forward are not bounded.
+const DefaultCallTimeout = 2 * time.Minute
+
+// callTimeout is the timeout shared by the wrapped clients of Settings.
+type callTimeout struct {
+	// nanoseconds is the call timeout, a value that is not positive disables it.
+	nanoseconds int64
+}
+
+// set changes the call timeout. It is safe for concurrent use.
+func (timeout *callTimeout) set(duration time.Duration) {
+	atomic.StoreInt64(&timeout.nanoseconds, int64(duration))
+}
+
+// get returns the call timeout. It is safe for concurrent use.
+func (timeout *callTimeout) get() time.Duration {
+	return time.Duration(atomic.LoadInt64(&timeout.nanoseconds))
+}
+
+// withTimeout returns a context derived from ctx that expires after the call timeout. The caller deadline is kept
+// if ctx already has one.
+func (timeout *callTimeout) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.TODO()
+	}
+
+	duration := timeout.get()
+
+	if _, hasDeadline := ctx.Deadline(); hasDeadline || duration <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, duration)
+}
+
+// timeoutClient is a controller-runtime client applying the call timeout of Settings to every call whose context has
+// no deadline, running the hooks of Settings after its successful writes and reporting the writes to the
+// StepReporter, if any. The caller context is always the parent of the call context, so its cancellation is
+// propagated. The Status and SubResource clients are wrapped the same way, without running hooks.
+type timeoutClient struct {
+	runtimeClient.Client
+	timeout *callTimeout
+	steps   stepReporterHolder
+	hooks   *hookRegistry
+}
+
+// newTimeoutClient wraps client with a timeoutClient using the given call timeout and hooks.
+func newTimeoutClient(client runtimeClient.Client, timeout *callTimeout, hooks *hookRegistry) *timeoutClient {
+	return &timeoutClient{Client: client, timeout: timeout, hooks: hooks}
+}
+
+// SetCallTimeout sets the timeout of every call sent through the controller-runtime client, the dynamic client and
+// the typed clientsets of Settings whose context has no deadline. A timeout that is not positive disables it. It has
+// no effect if the clients were not created by New. Clients built from Settings.Config are not bounded.
+func (settings *Settings) SetCallTimeout(timeout time.Duration) {
+	if settings == nil {
+		glog.V(100).Infof("Cannot set call timeout on nil settings")
+
+		return
+	}
+
+	glog.V(100).Infof("Setting API call timeout to %s", timeout)
+
+	settings.callTimeout.set(timeout)
+}
+
+// CallTimeout returns the timeout applied to calls without deadline, or 0 if there is none.
+func (settings *Settings) CallTimeout() time.Duration {
+	if settings == nil {
+		return 0
+	}
+
+	return settings.callTimeout.get()
+}
+
+// Get retrieves an obj for the given object key from the Kubernetes Cluster.
+func (client *timeoutClient) Get(
+	ctx context.Context, key runtimeClient.ObjectKey, obj runtimeClient.Object, opts ...runtimeClient.GetOption) error {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.Client.Get(ctx, key, obj, opts...)
+}
+
+// List retrieves list of objects for a given namespace and list options.
+func (client *timeoutClient) List(
+	ctx context.Context, list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) error {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.Client.List(ctx, list, opts...)
+}
+
+// Create saves the object obj in the Kubernetes cluster.
+func (client *timeoutClient) Create(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.CreateOption) error {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Client.Create(ctx, obj, opts...)
+	client.report("Create", obj, start, err)
+	client.runHooks(HookEventCreate, obj, err)
+
+	return err
+}
+
+// Delete deletes the given obj from Kubernetes cluster.
+func (client *timeoutClient) Delete(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.DeleteOption) error {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Client.Delete(ctx, obj, opts...)
+	client.report("Delete", obj, start, err)
+	client.runHooks(HookEventDelete, obj, err)
+
+	return err
+}
+
+// Update updates the given obj in the Kubernetes cluster.
+func (client *timeoutClient) Update(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.UpdateOption) error {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Client.Update(ctx, obj, opts...)
+	client.report("Update", obj, start, err)
+	client.runHooks(HookEventUpdate, obj, err)
+
+	return err
+}
+
+// Patch patches the given obj in the Kubernetes cluster.
+func (client *timeoutClient) Patch(ctx context.Context,
+	obj runtimeClient.Object, patch runtimeClient.Patch, opts ...runtimeClient.PatchOption) error {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Client.Patch(ctx, obj, patch, opts...)
+	client.report("Patch", obj, start, err)
+	client.runHooks(HookEventUpdate, obj, err)
+
+	return err
+}
+
+// DeleteAllOf deletes all objects of the given type matching the given options.
+func (client *timeoutClient) DeleteAllOf(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.DeleteAllOfOption) error {
+	ctx, cancel := client.timeout.withTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Client.DeleteAllOf(ctx, obj, opts...)
+	client.report("DeleteAllOf", obj, start, err)
+
+	return err
+}
+
+// Status returns a client for the status subresource of objects.
+func (client *timeoutClient) Status() runtimeClient.SubResourceWriter {
+	return client.SubResource("status")
+}
+
+// SubResource returns a client for the named subresource of objects.
+func (client *timeoutClient) SubResource(subResource string) runtimeClient.SubResourceClient {
+	return &timeoutSubResourceClient{
+		SubResourceClient: client.Client.SubResource(subResource),
+		parent:            client,
+		subResource:       subResource,
+	}
+}
+
+// runHooks runs the hooks registered for event on obj if the call succeeded.
+func (client *timeoutClient) runHooks(event HookEvent, obj runtimeClient.Object, err error) {
+	if err == nil {
+		client.hooks.run(event, obj)
+	}
+}
+
+// timeoutSubResourceClient is a controller-runtime subresource client applying the call timeout of its parent
+// timeoutClient and reporting its writes to the StepReporter, e.g. as "Update status".
+type timeoutSubResourceClient struct {
+	runtimeClient.SubResourceClient
+	parent      *timeoutClient
+	subResource string
+}
+
+// Get retrieves the subresource of obj into subResource.
+func (client *timeoutSubResourceClient) Get(ctx context.Context,
+	obj runtimeClient.Object, subResource runtimeClient.Object, opts ...runtimeClient.SubResourceGetOption) error {
+	ctx, cancel := client.parent.timeout.withTimeout(ctx)
+	defer cancel()
+
+	return client.SubResourceClient.Get(ctx, obj, subResource, opts...)
+}
+
+// Create saves the subResource object of obj in the Kubernetes cluster.
+func (client *timeoutSubResourceClient) Create(ctx context.Context,
+	obj runtimeClient.Object, subResource runtimeClient.Object, opts ...runtimeClient.SubResourceCreateOption) error {
+	ctx, cancel := client.parent.timeout.withTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	err := client.SubResourceClient.Create(ctx, obj, subResource, opts...)
+	client.parent.report(fmt.Sprintf("Create %s", client.subResource), obj, start, err)
+
+	return err
+}
+
+// Update updates the subresource of obj in the Kubernetes cluster.
+func (client *timeoutSubResourceClient) Update(
+	ctx context.Context, obj runtimeClient.Object, opts ...runtimeClient.SubResourceUpdateOption) error {
+	ctx, cancel := client.parent.timeout.withTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	err := client.SubResourceClient.Update(ctx, obj, opts...)
+	client.parent.report(fmt.Sprintf("Update %s", client.subResource), obj, start, err)
+
+	return err
+}
+
+// Patch patches the subresource of obj in the Kubernetes cluster.
+func (client *timeoutSubResourceClient) Patch(ctx context.Context,
+	obj runtimeClient.Object, patch runtimeClient.Patch, opts ...runtimeClient.SubResourcePatchOption) error {
+	ctx, cancel := client.parent.timeout.withTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	err := client.SubResourceClient.Patch(ctx, obj, patch, opts...)
+	client.parent.report(fmt.Sprintf("Patch %s", client.subResource), obj, start, err)
+
+	return err
+}
@@ -0,0 +1,76 @@
+package clients
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport"
+)
+
+// timeoutRoundTripper applies the call timeout of Settings to the requests of the typed clientsets whose context has
+// no deadline. Watches, followed logs and upgraded connections are long-lived and are not bounded.
+type timeoutRoundTripper struct {
+	delegate http.RoundTripper
+	timeout  *callTimeout
+}
+
+// withCallTimeout returns a copy of config whose transport applies the given call timeout.
+func withCallTimeout(config *rest.Config, timeout *callTimeout) *rest.Config {
+	timeoutConfig := rest.CopyConfig(config)
+	timeoutConfig.WrapTransport = transport.Wrappers(timeoutConfig.WrapTransport,
+		func(delegate http.RoundTripper) http.RoundTripper {
+			return &timeoutRoundTripper{delegate: delegate, timeout: timeout}
+		})
+
+	return timeoutConfig
+}
+
+// RoundTrip sends the request with the call timeout. The timeout keeps running until the response body is closed,
+// so that reading the body is bounded as well.
+func (roundTripper *timeoutRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if isLongRunning(request) {
+		return roundTripper.delegate.RoundTrip(request)
+	}
+
+	ctx, cancel := roundTripper.timeout.withTimeout(request.Context())
+
+	response, err := roundTripper.delegate.RoundTrip(request.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	response.Body = &cancelOnClose{ReadCloser: response.Body, cancel: cancel}
+
+	return response, nil
+}
+
+// WrappedRoundTripper returns the round tripper the requests are sent through.
+func (roundTripper *timeoutRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return roundTripper.delegate
+}
+
+// cancelOnClose releases the context of a request once its response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the context of the request.
+func (body *cancelOnClose) Close() error {
+	defer body.cancel()
+
+	return body.ReadCloser.Close()
+}
+
+// isLongRunning returns true for the requests that are expected to stay open, i.e. watches, followed logs and
+// connection upgrades such as exec and port-forward.
+func isLongRunning(request *http.Request) bool {
+	query := request.URL.Query()
+
+	return query.Get("watch") == "true" || query.Get("watch") == "1" || query.Get("follow") == "true" ||
+		request.Header.Get("Upgrade") != ""
+}
@@ -0,0 +1,182 @@
+package clusterbaremetal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/bmh"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// deleteMachineAnnotation marks the machine the machineset controller removes first on scale down.
+	deleteMachineAnnotation = "machine.openshift.io/delete-machine"
+)
+
+var (
+	// machineSetGVR is the resource of the OpenShift machinesets, whose API is not vendored.
+	machineSetGVR = schema.GroupVersionResource{Group: "machine.openshift.io", Version: "v1beta1", Resource: "machinesets"}
+	// machineGVR is the resource of the OpenShift machines, whose API is not vendored.
+	machineGVR = schema.GroupVersionResource{Group: "machine.openshift.io", Version: "v1beta1", Resource: "machines"}
+)
+
+// machineSetReplicas returns the desired number of replicas of the machineset.
+func (scenario *Scenario) machineSetReplicas() (int64, error) {
+	machineSet, err := scenario.apiClient.Resource(machineSetGVR).Namespace(scenario.MachineNamespace).Get(
+		context.TODO(), scenario.MachineSetName, metaV1.GetOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get machineset %s: %w", scenario.MachineSetName, err)
+	}
+
+	replicas, _, err := unstructured.NestedInt64(machineSet.Object, "spec", "replicas")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read replicas of machineset %s: %w", scenario.MachineSetName, err)
+	}
+
+	return replicas, nil
+}
+
+// scaleMachineSet sets the desired number of replicas of the machineset.
+func (scenario *Scenario) scaleMachineSet(replicas int64) error {
+	glog.V(100).Infof("Scaling machineset %s in namespace %s to %d replicas",
+		scenario.MachineSetName, scenario.MachineNamespace, replicas)
+
+	if replicas < 0 {
+		return fmt.Errorf("cannot scale machineset %s to %d replicas", scenario.MachineSetName, replicas)
+	}
+
+	return scenario.mergePatch(machineSetGVR, scenario.MachineSetName, map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": replicas},
+	})
+}
+
+// markMachine adds or removes the annotation making the machine the first one removed on scale down. A machine
+// that is already gone is not an error when removing the annotation.
+func (scenario *Scenario) markMachine(machineName string, marked bool) error {
+	glog.V(100).Infof("Setting deletion mark of machine %s in namespace %s to %t",
+		machineName, scenario.MachineNamespace, marked)
+
+	var value interface{}
+	if marked {
+		value = "true"
+	}
+
+	err := scenario.mergePatch(machineGVR, machineName, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{deleteMachineAnnotation: value},
+		},
+	})
+	if !marked && k8serrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// consumingMachine returns the name of the machine consuming the bmh, read from its consumer reference.
+func (scenario *Scenario) consumingMachine(host *bmh.BmhBuilder) (string, error) {
+	if !host.Refresh() || host.Object == nil {
+		return "", fmt.Errorf("bmh %s does not exist", host.ObjectName())
+	}
+
+	consumerRef := host.Object.Spec.ConsumerRef
+	if consumerRef == nil || consumerRef.Kind != "Machine" || consumerRef.Name == "" {
+		return "", fmt.Errorf("bmh %s is not consumed by a machine", host.ObjectName())
+	}
+
+	return consumerRef.Name, nil
+}
+
+// machineNodeName returns the name of the node of the machine, or an empty string if it has none yet.
+func (scenario *Scenario) machineNodeName(machineName string) (string, error) {
+	machine, err := scenario.apiClient.Resource(machineGVR).Namespace(scenario.MachineNamespace).Get(
+		context.TODO(), machineName, metaV1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get machine %s: %w", machineName, err)
+	}
+
+	nodeName, _, _ := unstructured.NestedString(machine.Object, "status", "nodeRef", "name")
+
+	return nodeName, nil
+}
+
+// waitNodeReady waits for the step timeout or until the machine references a node that is ready, and returns the
+// name of the node.
+func (scenario *Scenario) waitNodeReady(machineName string) (string, error) {
+	var nodeName, lastObserved string
+
+	err := await.Poll(scenario.StepTimeout, func() (bool, error) {
+		var err error
+
+		nodeName, err = scenario.machineNodeName(machineName)
+		if err != nil || nodeName == "" {
+			lastObserved = fmt.Sprintf("machine %s has no node", machineName)
+
+			return false, nil
+		}
+
+		node, err := scenario.apiClient.CoreV1Interface.Nodes().Get(context.TODO(), nodeName, metaV1.GetOptions{})
+		if err != nil {
+			lastObserved = fmt.Sprintf("node %s not found", nodeName)
+
+			return false, nil
+		}
+
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady {
+				lastObserved = fmt.Sprintf("node %s ready %s", nodeName, condition.Status)
+
+				return condition.Status == corev1.ConditionTrue, nil
+			}
+		}
+
+		lastObserved = fmt.Sprintf("node %s has no ready condition", nodeName)
+
+		return false, nil
+	})
+
+	return nodeName, await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          schema.GroupVersionKind{Group: machineGVR.Group, Version: machineGVR.Version, Kind: "Machine"},
+		Name:         machineName,
+		Namespace:    scenario.MachineNamespace,
+		Wanted:       "ready node",
+		LastObserved: lastObserved,
+	})
+}
+
+// waitNodeDeleted waits for the step timeout or until the node is removed from the cluster.
+func (scenario *Scenario) waitNodeDeleted(nodeName string) error {
+	err := await.Poll(scenario.StepTimeout, func() (bool, error) {
+		_, err := scenario.apiClient.CoreV1Interface.Nodes().Get(context.TODO(), nodeName, metaV1.GetOptions{})
+
+		return k8serrors.IsNotFound(err), nil
+	})
+
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          corev1.SchemeGroupVersion.WithKind("Node"),
+		Name:         nodeName,
+		Wanted:       "deletion",
+		LastObserved: "node still present",
+	})
+}
+
+// mergePatch sends a merge patch built from patch to the named object of the given resource.
+func (scenario *Scenario) mergePatch(gvr schema.GroupVersionResource, name string, patch interface{}) error {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	_, err = scenario.apiClient.Resource(gvr).Namespace(scenario.MachineNamespace).Patch(
+		context.TODO(), name, types.MergePatchType, data, metaV1.PatchOptions{})
+
+	return err
+}
@@ -0,0 +1,203 @@
+package clusterbaremetal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	// DefaultMachineNamespace is the namespace of the machines and machinesets on OpenShift.
+	DefaultMachineNamespace = "openshift-machine-api"
+	// DefaultPoolName is the MachineConfigPool new workers join.
+	DefaultPoolName = "worker"
+	// DefaultStepTimeout is the time a single step of a scenario may take.
+	DefaultStepTimeout = 60 * time.Minute
+)
+
+// Step identifies a step of a worker scenario.
+type Step string
+
+const (
+	// StepCreateHost creates the bmh and its BMC credentials.
+	StepCreateHost Step = "CreateHost"
+	// StepWaitHostAvailable waits for the bmh to be inspected and available.
+	StepWaitHostAvailable Step = "WaitHostAvailable"
+	// StepScaleUp adds a replica to the machineset so that a machine claims the bmh.
+	StepScaleUp Step = "ScaleUp"
+	// StepWaitHostProvisioned waits for the bmh to be provisioned.
+	StepWaitHostProvisioned Step = "WaitHostProvisioned"
+	// StepWaitNodeReady waits for the node of the machine to join the cluster and be ready.
+	StepWaitNodeReady Step = "WaitNodeReady"
+	// StepWaitPoolUpdated waits for the MachineConfigPool to be updated.
+	StepWaitPoolUpdated Step = "WaitPoolUpdated"
+	// StepMarkMachine marks the machine consuming the bmh as the one to remove on scale down.
+	StepMarkMachine Step = "MarkMachine"
+	// StepScaleDown removes a replica from the machineset.
+	StepScaleDown Step = "ScaleDown"
+	// StepWaitNodeDeleted waits for the node of the machine to be removed from the cluster.
+	StepWaitNodeDeleted Step = "WaitNodeDeleted"
+	// StepDeleteHost deletes the bmh and waits until it is gone.
+	StepDeleteHost Step = "DeleteHost"
+	// StepRollback undoes the completed steps of a failed scenario.
+	StepRollback Step = "Rollback"
+)
+
+// Progress reports the start or the end of a step. Err is only set at the end of a failed step.
+type Progress struct {
+	Step    Step
+	Done    bool
+	Err     error
+	Elapsed time.Duration
+}
+
+// ProgressFunc is called at the start and at the end of every step of a scenario.
+type ProgressFunc func(progress Progress)
+
+// Scenario runs the most common baremetal day-2 operations, adding and removing a worker backed by a bmh, on top
+// of the bmh, mco and nodes packages. A failed scenario rolls back the steps it completed, where possible.
+type Scenario struct {
+	apiClient *clients.Settings
+	// MachineSetName is the machineset that is scaled to add or remove the worker.
+	MachineSetName string
+	// MachineNamespace is the namespace of the machineset, its machines and the bmh.
+	MachineNamespace string
+	// PoolName is the MachineConfigPool the worker joins. The pool is not waited for if empty.
+	PoolName string
+	// StepTimeout is the time each waiting step may take.
+	StepTimeout time.Duration
+	// OnProgress is called at the start and at the end of every step, if not nil.
+	OnProgress ProgressFunc
+}
+
+// step is a single step of a scenario. rollback, if not nil, undoes the step once it completed.
+type step struct {
+	name     Step
+	run      func() error
+	rollback func() error
+}
+
+// NewScenario creates a new instance of Scenario scaling the given machineset, with the default namespace, pool
+// and step timeout.
+func NewScenario(apiClient *clients.Settings, machineSetName string) (*Scenario, error) {
+	glog.V(100).Infof("Initializing new baremetal worker scenario for machineset %s", machineSetName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to initialize scenario, 'apiClient' parameter is nil")
+	}
+
+	if machineSetName == "" {
+		glog.V(100).Infof("The machineset name is empty")
+
+		return nil, fmt.Errorf("failed to initialize scenario, 'machineSetName' parameter is empty")
+	}
+
+	return &Scenario{
+		apiClient:        apiClient,
+		MachineSetName:   machineSetName,
+		MachineNamespace: DefaultMachineNamespace,
+		PoolName:         DefaultPoolName,
+		StepTimeout:      DefaultStepTimeout,
+	}, nil
+}
+
+// run runs the steps in order. If a step fails, the rollbacks of the completed steps run in reverse order and
+// their errors are returned together with the error of the failed step.
+func (scenario *Scenario) run(steps []step) error {
+	var completed []step
+
+	for _, current := range steps {
+		err := scenario.runStep(current.name, current.run)
+		if err == nil {
+			completed = append(completed, current)
+
+			continue
+		}
+
+		errs := []error{fmt.Errorf("step %s failed: %w", current.name, err)}
+
+		if rollbackErr := scenario.runStep(StepRollback, func() error {
+			return rollback(completed)
+		}); rollbackErr != nil {
+			errs = append(errs, fmt.Errorf("rollback failed: %w", rollbackErr))
+		}
+
+		return utilerrors.NewAggregate(errs)
+	}
+
+	return nil
+}
+
+// runStep runs a single step and reports its start and end to the progress callback.
+func (scenario *Scenario) runStep(name Step, run func() error) error {
+	glog.V(100).Infof("Running baremetal worker scenario step %s", name)
+
+	scenario.report(Progress{Step: name})
+
+	start := time.Now()
+	err := run()
+
+	scenario.report(Progress{Step: name, Done: true, Err: err, Elapsed: time.Since(start)})
+
+	return err
+}
+
+// report calls the progress callback, if any.
+func (scenario *Scenario) report(progress Progress) {
+	if scenario.OnProgress != nil {
+		scenario.OnProgress(progress)
+	}
+}
+
+// validate checks that the scenario can run.
+func (scenario *Scenario) validate() error {
+	if scenario == nil {
+		glog.V(100).Infof("The scenario is undefined")
+
+		return fmt.Errorf("error: received nil scenario")
+	}
+
+	if scenario.apiClient == nil {
+		glog.V(100).Infof("The scenario apiClient is nil")
+
+		return fmt.Errorf("scenario cannot have nil apiClient")
+	}
+
+	if scenario.MachineSetName == "" || scenario.MachineNamespace == "" {
+		glog.V(100).Infof("The scenario machineset name or namespace is empty")
+
+		return fmt.Errorf("scenario machineset name and namespace cannot be empty")
+	}
+
+	if scenario.StepTimeout <= 0 {
+		glog.V(100).Infof("The scenario step timeout is not positive")
+
+		return fmt.Errorf("scenario step timeout must be greater than 0")
+	}
+
+	return nil
+}
+
+// rollback undoes the given completed steps in reverse order and returns the aggregated errors.
+func rollback(completed []step) error {
+	var errs []error
+
+	for index := len(completed) - 1; index >= 0; index-- {
+		if completed[index].rollback == nil {
+			continue
+		}
+
+		glog.V(100).Infof("Rolling back baremetal worker scenario step %s", completed[index].name)
+
+		if err := completed[index].rollback(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to roll back step %s: %w", completed[index].name, err))
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,190 @@
+package clusterbaremetal
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/bmh"
+	"github.com/openshift-kni/eco-goinfra/pkg/mco"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// AddWorker adds a worker backed by the given bmh to the cluster and returns the name of its node. The bmh is
+// created if it does not exist, then the machineset is scaled up so that its new machine claims and provisions the
+// bmh. The bmh must therefore be the only available host matching the host selector of the machineset. If a step
+// fails, the machineset is scaled back and a bmh created by the scenario is deleted.
+func (scenario *Scenario) AddWorker(host *bmh.BmhBuilder) (string, error) {
+	if err := scenario.validate(); err != nil {
+		return "", err
+	}
+
+	if host == nil {
+		glog.V(100).Infof("The bmh builder is nil")
+
+		return "", fmt.Errorf("failed to add worker, 'host' parameter is nil")
+	}
+
+	glog.V(100).Infof("Adding worker backed by baremetalhost %s to machineset %s",
+		host.ObjectName(), scenario.MachineSetName)
+
+	var (
+		created  bool
+		replicas int64
+		nodeName string
+	)
+
+	err := scenario.run([]step{
+		{
+			name: StepCreateHost,
+			run: func() error {
+				if host.Refresh() {
+					return nil
+				}
+
+				created = true
+				_, err := host.Create()
+
+				return err
+			},
+			rollback: func() error {
+				if !created {
+					return nil
+				}
+
+				_, err := host.DeleteAndWaitUntilDeleted(scenario.StepTimeout)
+
+				return err
+			},
+		},
+		{name: StepWaitHostAvailable, run: func() error { return host.WaitUntilAvailable(scenario.StepTimeout) }},
+		{
+			name: StepScaleUp,
+			run: func() error {
+				var err error
+				if replicas, err = scenario.machineSetReplicas(); err != nil {
+					return err
+				}
+
+				return scenario.scaleMachineSet(replicas + 1)
+			},
+			rollback: func() error {
+				// Without the mark the machineset controller might remove another worker on scale down.
+				if machineName, err := scenario.consumingMachine(host); err == nil {
+					if err := scenario.markMachine(machineName, true); err != nil {
+						return err
+					}
+				}
+
+				return scenario.scaleMachineSet(replicas)
+			},
+		},
+		{name: StepWaitHostProvisioned, run: func() error { return host.WaitUntilProvisioned(scenario.StepTimeout) }},
+		{
+			name: StepWaitNodeReady,
+			run: func() error {
+				machineName, err := scenario.consumingMachine(host)
+				if err != nil {
+					return err
+				}
+
+				nodeName, err = scenario.waitNodeReady(machineName)
+
+				return err
+			},
+		},
+		{name: StepWaitPoolUpdated, run: scenario.waitPoolUpdated},
+	})
+
+	return nodeName, err
+}
+
+// RemoveWorker removes the worker backed by the given bmh from the cluster by marking its machine for deletion and
+// scaling the machineset down, then waits for the bmh to be deprovisioned and available again. If deleteHost is
+// true, the bmh is deleted afterwards. Only the deletion mark is rolled back on failure, since a machine cannot be
+// restored once the machineset is scaled down.
+func (scenario *Scenario) RemoveWorker(host *bmh.BmhBuilder, deleteHost bool) error {
+	if err := scenario.validate(); err != nil {
+		return err
+	}
+
+	if host == nil {
+		glog.V(100).Infof("The bmh builder is nil")
+
+		return fmt.Errorf("failed to remove worker, 'host' parameter is nil")
+	}
+
+	glog.V(100).Infof("Removing worker backed by baremetalhost %s from machineset %s",
+		host.ObjectName(), scenario.MachineSetName)
+
+	var machineName, nodeName string
+
+	steps := []step{
+		{
+			name: StepMarkMachine,
+			run: func() error {
+				var err error
+				if machineName, err = scenario.consumingMachine(host); err != nil {
+					return err
+				}
+
+				if nodeName, err = scenario.machineNodeName(machineName); err != nil {
+					return err
+				}
+
+				return scenario.markMachine(machineName, true)
+			},
+			rollback: func() error { return scenario.markMachine(machineName, false) },
+		},
+		{name: StepScaleDown, run: scenario.scaleDown},
+		{
+			name: StepWaitNodeDeleted,
+			run: func() error {
+				if nodeName == "" {
+					return nil
+				}
+
+				return scenario.waitNodeDeleted(nodeName)
+			},
+		},
+		{name: StepWaitHostAvailable, run: func() error { return host.WaitUntilAvailable(scenario.StepTimeout) }},
+	}
+
+	if deleteHost {
+		steps = append(steps, step{name: StepDeleteHost, run: func() error {
+			_, err := host.DeleteAndWaitUntilDeleted(scenario.StepTimeout)
+
+			return err
+		}})
+	}
+
+	return scenario.run(steps)
+}
+
+// scaleDown removes a replica from the machineset.
+func (scenario *Scenario) scaleDown() error {
+	replicas, err := scenario.machineSetReplicas()
+	if err != nil {
+		return err
+	}
+
+	if replicas < 1 {
+		return fmt.Errorf("machineset %s has no replica to remove", scenario.MachineSetName)
+	}
+
+	return scenario.scaleMachineSet(replicas - 1)
+}
+
+// waitPoolUpdated waits for the MachineConfigPool of the scenario to be updated. Nothing is waited for if the
+// scenario has no pool.
+func (scenario *Scenario) waitPoolUpdated() error {
+	if scenario.PoolName == "" {
+		return nil
+	}
+
+	pool, err := mco.Pull(scenario.apiClient, scenario.PoolName)
+	if err != nil {
+		return err
+	}
+
+	return pool.WaitToBeInCondition(mco.ConditionUpdated, corev1.ConditionTrue, scenario.StepTimeout)
+}
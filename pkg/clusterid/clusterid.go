@@ -0,0 +1,154 @@
+package clusterid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/clusterversion"
+	"github.com/openshift-kni/eco-goinfra/pkg/secret"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// TelemetryRegistry is the pull secret auth entry used by the cluster to send telemetry.
+	TelemetryRegistry = "cloud.openshift.com"
+
+	telemeterClientName      = "telemeter-client"
+	telemeterClientNamespace = "openshift-monitoring"
+)
+
+// GetClusterID returns the unique identifier of the cluster from its ClusterVersion.
+func GetClusterID(apiClient *clients.Settings) (string, error) {
+	glog.V(100).Infof("Getting cluster ID")
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return "", fmt.Errorf("failed to get cluster ID, 'apiClient' parameter is nil")
+	}
+
+	clusterVersion, err := clusterversion.Pull(apiClient)
+	if err != nil {
+		return "", err
+	}
+
+	if clusterVersion.Object.Spec.ClusterID == "" {
+		return "", fmt.Errorf("clusterversion does not define a cluster ID")
+	}
+
+	return string(clusterVersion.Object.Spec.ClusterID), nil
+}
+
+// DisableTelemetry opts the cluster out of telemetry by removing the TelemetryRegistry entry from the global
+// pull secret. It is a no-op if the entry is already absent.
+func DisableTelemetry(apiClient *clients.Settings) error {
+	glog.V(100).Infof("Disabling telemetry by removing %s from the global pull secret", TelemetryRegistry)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return fmt.Errorf("failed to disable telemetry, 'apiClient' parameter is nil")
+	}
+
+	pullSecret, err := secret.Pull(apiClient, secret.GlobalPullSecretName, secret.GlobalPullSecretNamespace)
+	if err != nil {
+		return err
+	}
+
+	dockerConfig, err := getDockerConfig(pullSecret.Object)
+	if err != nil {
+		return err
+	}
+
+	auths, _ := dockerConfig["auths"].(map[string]interface{})
+	if _, found := auths[TelemetryRegistry]; !found {
+		glog.V(100).Infof("The global pull secret has no %s entry", TelemetryRegistry)
+
+		return nil
+	}
+
+	delete(auths, TelemetryRegistry)
+
+	updatedConfig, err := json.Marshal(dockerConfig)
+	if err != nil {
+		return fmt.Errorf("failed to encode global pull secret: %w", err)
+	}
+
+	pullSecret.Object.Data[v1.DockerConfigJsonKey] = updatedConfig
+
+	updatedSecret, err := apiClient.Secrets(secret.GlobalPullSecretNamespace).Update(
+		context.TODO(), pullSecret.Object, metaV1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update global pull secret: %w", err)
+	}
+
+	apiClient.RunHooks(clients.HookEventUpdate, updatedSecret)
+
+	return nil
+}
+
+// VerifyTelemetryDisabled returns the telemetry configuration remaining on the cluster. An empty result means
+// the cluster does not send telemetry.
+func VerifyTelemetryDisabled(apiClient *clients.Settings) ([]string, error) {
+	glog.V(100).Infof("Verifying telemetry is disabled")
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to verify telemetry, 'apiClient' parameter is nil")
+	}
+
+	var findings []string
+
+	pullSecret, err := secret.Pull(apiClient, secret.GlobalPullSecretName, secret.GlobalPullSecretNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	dockerConfig, err := getDockerConfig(pullSecret.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	auths, _ := dockerConfig["auths"].(map[string]interface{})
+	if _, found := auths[TelemetryRegistry]; found {
+		findings = append(findings, fmt.Sprintf("global pull secret contains %s credentials", TelemetryRegistry))
+	}
+
+	telemeterClient, err := apiClient.Deployments(telemeterClientNamespace).Get(
+		context.TODO(), telemeterClientName, metaV1.GetOptions{})
+
+	switch {
+	case k8serrors.IsNotFound(err):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get %s deployment: %w", telemeterClientName, err)
+	case telemeterClient.Status.AvailableReplicas > 0:
+		findings = append(findings, fmt.Sprintf("%s deployment in namespace %s has %d available replicas",
+			telemeterClientName, telemeterClientNamespace, telemeterClient.Status.AvailableReplicas))
+	}
+
+	return findings, nil
+}
+
+// getDockerConfig decodes the dockerconfigjson of the given secret.
+func getDockerConfig(pullSecret *v1.Secret) (map[string]interface{}, error) {
+	rawConfig, ok := pullSecret.Data[v1.DockerConfigJsonKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s in namespace %s has no %s key",
+			pullSecret.Name, pullSecret.Namespace, v1.DockerConfigJsonKey)
+	}
+
+	var dockerConfig map[string]interface{}
+
+	if err := json.Unmarshal(rawConfig, &dockerConfig); err != nil {
+		return nil, fmt.Errorf("failed to decode secret %s in namespace %s: %w",
+			pullSecret.Name, pullSecret.Namespace, err)
+	}
+
+	return dockerConfig, nil
+}
@@ -0,0 +1,87 @@
+package clusteroperator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	configv1 "github.com/openshift/api/config/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetNotAvailable returns the names of the clusteroperators that are not Available or are Degraded.
+func GetNotAvailable(apiClient *clients.Settings) ([]string, error) {
+	glog.V(100).Infof("Getting clusteroperators that are not available")
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to get clusteroperators, 'apiClient' parameter is nil")
+	}
+
+	operatorList, err := apiClient.ConfigV1Interface.ClusterOperators().List(context.TODO(), metaV1.ListOptions{})
+	if err != nil {
+		glog.V(100).Infof("Failed to list clusteroperators due to %s", err.Error())
+
+		return nil, err
+	}
+
+	if len(operatorList.Items) == 0 {
+		return nil, fmt.Errorf("no clusteroperators found")
+	}
+
+	var notAvailable []string
+
+	for _, operator := range operatorList.Items {
+		if !isConditionTrue(operator.Status.Conditions, configv1.OperatorAvailable) ||
+			isConditionTrue(operator.Status.Conditions, configv1.OperatorDegraded) {
+			notAvailable = append(notAvailable, operator.Name)
+		}
+	}
+
+	sort.Strings(notAvailable)
+
+	return notAvailable, nil
+}
+
+// WaitForAllAvailable waits for timeout duration or until all clusteroperators are Available and not Degraded.
+func WaitForAllAvailable(apiClient *clients.Settings, timeout time.Duration, options ...await.WaitOption) error {
+	glog.V(100).Infof("Waiting for all clusteroperators to be available")
+
+	var notAvailable []string
+
+	err := await.Poll(timeout, func() (bool, error) {
+		operators, err := GetNotAvailable(apiClient)
+		if err != nil {
+			glog.V(100).Infof("Failed to get clusteroperators: %s", err.Error())
+
+			return false, nil
+		}
+
+		notAvailable = operators
+
+		return len(notAvailable) == 0, nil
+	}, options...)
+
+	if err != nil {
+		return fmt.Errorf("clusteroperators %v are not available: %w", notAvailable, err)
+	}
+
+	return nil
+}
+
+// isConditionTrue checks whether the condition of the given type is present and true.
+func isConditionTrue(
+	conditions []configv1.ClusterOperatorStatusCondition, conditionType configv1.ClusterStatusConditionType) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition.Status == configv1.ConditionTrue
+		}
+	}
+
+	return false
+}
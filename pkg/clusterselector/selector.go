@@ -0,0 +1,221 @@
+package clusterselector
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/bmh"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// nfdLabelPrefix is the prefix of the labels node-feature-discovery sets on the nodes.
+	nfdLabelPrefix = "feature.node.kubernetes.io/"
+	// nfdSriovCapableLabel is set by node-feature-discovery on nodes with an SR-IOV capable NIC.
+	nfdSriovCapableLabel = nfdLabelPrefix + "network-sriov.capable"
+	// nfdNICPresentLabelFormat is the label node-feature-discovery sets for a present network controller of the
+	// given PCI vendor, with the default device label fields class and vendor.
+	nfdNICPresentLabelFormat = nfdLabelPrefix + "pci-0200_%s.present"
+	// gigabyte is the number of bytes in a gigabyte, as used for disk sizes.
+	gigabyte = 1000 * 1000 * 1000
+)
+
+// virtualizationCPUFlags are the CPU flags of the Intel and AMD hardware virtualization extensions.
+var virtualizationCPUFlags = []string{"vmx", "svm"}
+
+// virtualizationNodeLabels are the node-feature-discovery labels of the Intel and AMD virtualization extensions.
+var virtualizationNodeLabels = []string{nfdLabelPrefix + "cpu-cpuid.VMX", nfdLabelPrefix + "cpu-cpuid.SVM"}
+
+// Requirements are the capabilities a host or node must have to be selected. Zero values are not checked.
+type Requirements struct {
+	// MinCPUs is the minimum number of logical CPUs.
+	MinCPUs int
+	// MinRAMMebibytes is the minimum amount of memory in MiB.
+	MinRAMMebibytes int
+	// MinDiskGigabytes is the minimum size of the largest disk of a host, or of the ephemeral storage of a node.
+	MinDiskGigabytes int
+	// NICVendor is the PCI vendor ID of a NIC that must be present, e.g. "8086" or "15b3".
+	NICVendor string
+	// SriovCapable requires an SR-IOV capable NIC. It is only checked on nodes, since the bmh hardware details do
+	// not report it.
+	SriovCapable bool
+	// Virtualization requires the hardware virtualization extensions of the CPU.
+	Virtualization bool
+}
+
+// UnmetHostRequirements returns the requirements the given bmh hardware details do not meet. An empty result means
+// the host matches.
+func (requirements Requirements) UnmetHostRequirements(hardware *bmhv1alpha1.HardwareDetails) []string {
+	if hardware == nil {
+		return []string{"no hardware details"}
+	}
+
+	var unmet []string
+
+	if hardware.CPU.Count < requirements.MinCPUs {
+		unmet = append(unmet, fmt.Sprintf("%d CPUs, need %d", hardware.CPU.Count, requirements.MinCPUs))
+	}
+
+	if hardware.RAMMebibytes < requirements.MinRAMMebibytes {
+		unmet = append(unmet, fmt.Sprintf("%d MiB RAM, need %d", hardware.RAMMebibytes, requirements.MinRAMMebibytes))
+	}
+
+	var largestDisk bmhv1alpha1.Capacity
+
+	for _, disk := range hardware.Storage {
+		if disk.SizeBytes > largestDisk {
+			largestDisk = disk.SizeBytes
+		}
+	}
+
+	if int64(largestDisk) < int64(requirements.MinDiskGigabytes)*gigabyte {
+		unmet = append(unmet, fmt.Sprintf("largest disk %d GB, need %d",
+			int64(largestDisk)/gigabyte, requirements.MinDiskGigabytes))
+	}
+
+	if requirements.NICVendor != "" && !slices.ContainsFunc(hardware.NIC, func(nic bmhv1alpha1.NIC) bool {
+		return nicModelVendor(nic.Model) == normalizeVendor(requirements.NICVendor)
+	}) {
+		unmet = append(unmet, fmt.Sprintf("no NIC of vendor %s", requirements.NICVendor))
+	}
+
+	if requirements.Virtualization && !slices.ContainsFunc(hardware.CPU.Flags, func(flag string) bool {
+		return slices.Contains(virtualizationCPUFlags, flag)
+	}) {
+		unmet = append(unmet, "no hardware virtualization")
+	}
+
+	return unmet
+}
+
+// UnmetNodeRequirements returns the requirements the given node does not meet, based on its capacity and the
+// labels of node-feature-discovery. An empty result means the node matches.
+func (requirements Requirements) UnmetNodeRequirements(node *corev1.Node) []string {
+	if node == nil {
+		return []string{"no node"}
+	}
+
+	var unmet []string
+
+	capacity := node.Status.Capacity
+
+	if cpus := capacity.Cpu().Value(); cpus < int64(requirements.MinCPUs) {
+		unmet = append(unmet, fmt.Sprintf("%d CPUs, need %d", cpus, requirements.MinCPUs))
+	}
+
+	if memory := capacity.Memory().Value() / (1024 * 1024); memory < int64(requirements.MinRAMMebibytes) {
+		unmet = append(unmet, fmt.Sprintf("%d MiB RAM, need %d", memory, requirements.MinRAMMebibytes))
+	}
+
+	if storage := capacity.StorageEphemeral().Value() / gigabyte; storage < int64(requirements.MinDiskGigabytes) {
+		unmet = append(unmet, fmt.Sprintf("%d GB ephemeral storage, need %d", storage, requirements.MinDiskGigabytes))
+	}
+
+	if requirements.NICVendor != "" &&
+		node.Labels[fmt.Sprintf(nfdNICPresentLabelFormat, normalizeVendor(requirements.NICVendor))] != "true" {
+		unmet = append(unmet, fmt.Sprintf("no NIC of vendor %s", requirements.NICVendor))
+	}
+
+	if requirements.SriovCapable && node.Labels[nfdSriovCapableLabel] != "true" {
+		unmet = append(unmet, "no SR-IOV capable NIC")
+	}
+
+	if requirements.Virtualization && !slices.ContainsFunc(virtualizationNodeLabels, func(label string) bool {
+		return node.Labels[label] == "true"
+	}) {
+		unmet = append(unmet, "no hardware virtualization")
+	}
+
+	return unmet
+}
+
+// SelectHosts returns the bmhs in the given namespaces, or in all namespaces if none is given, whose hardware
+// details meet the requirements. SriovCapable cannot be checked on bmhs and is rejected.
+func SelectHosts(apiClient *clients.Settings, requirements Requirements, nsnames ...string) ([]*bmh.BmhBuilder, error) {
+	glog.V(100).Infof("Selecting baremetalhosts in namespaces %v meeting %+v", nsnames, requirements)
+
+	if requirements.SriovCapable {
+		glog.V(100).Infof("The SR-IOV capability cannot be checked on baremetalhosts")
+
+		return nil, fmt.Errorf("failed to select hosts, SR-IOV capability is not reported by baremetalhosts")
+	}
+
+	var (
+		bmhBuilders []*bmh.BmhBuilder
+		err         error
+	)
+
+	if len(nsnames) == 0 {
+		bmhBuilders, err = bmh.ListAll(apiClient)
+	} else {
+		bmhBuilders, err = bmh.ListInNamespaces(apiClient, nsnames)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []*bmh.BmhBuilder
+
+	for _, bmhBuilder := range bmhBuilders {
+		unmet := requirements.UnmetHostRequirements(bmhBuilder.Object.Status.HardwareDetails)
+		if len(unmet) > 0 {
+			glog.V(100).Infof("Baremetalhost %s/%s not selected: %s",
+				bmhBuilder.Object.Namespace, bmhBuilder.Object.Name, strings.Join(unmet, ", "))
+
+			continue
+		}
+
+		selected = append(selected, bmhBuilder)
+	}
+
+	return selected, nil
+}
+
+// SelectNodes returns the nodes matching the given label selector whose capacity and node-feature-discovery labels
+// meet the requirements.
+func SelectNodes(
+	apiClient *clients.Settings, requirements Requirements, selector map[string]string) ([]*nodes.NodeBuilder, error) {
+	glog.V(100).Infof("Selecting nodes with labels %v meeting %+v", selector, requirements)
+
+	nodesBuilder := nodes.NewBuilder(apiClient, selector)
+
+	if err := nodesBuilder.Discover(); err != nil {
+		return nil, err
+	}
+
+	var selected []*nodes.NodeBuilder
+
+	for _, nodeBuilder := range nodesBuilder.Objects {
+		unmet := requirements.UnmetNodeRequirements(nodeBuilder.Object)
+		if len(unmet) > 0 {
+			glog.V(100).Infof("Node %s not selected: %s", nodeBuilder.Object.Name, strings.Join(unmet, ", "))
+
+			continue
+		}
+
+		selected = append(selected, nodeBuilder)
+	}
+
+	return selected, nil
+}
+
+// nicModelVendor returns the PCI vendor ID of a NIC model reported by ironic, e.g. "8086" for "0x8086 0x158b".
+func nicModelVendor(model string) string {
+	fields := strings.Fields(model)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return normalizeVendor(fields[0])
+}
+
+// normalizeVendor returns the PCI vendor ID in lower case without 0x prefix.
+func normalizeVendor(vendor string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(vendor)), "0x")
+}
@@ -0,0 +1,91 @@
+package clusterversion
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/clusteroperator"
+	v1 "github.com/openshift/api/config/v1"
+)
+
+// WaitUntilCompleted waits for timeout duration or until the latest update of the clusterversion is completed and
+// the cluster reports the Available condition.
+func (builder *Builder) WaitUntilCompleted(timeout time.Duration, options ...await.WaitOption) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for clusterversion %s to complete", builder.Definition.Name)
+
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
+		if !builder.Exists() || builder.Object == nil {
+			return false, nil
+		}
+
+		if len(builder.Object.Status.History) == 0 {
+			lastObserved = "no update history"
+
+			return false, nil
+		}
+
+		latest := builder.Object.Status.History[0]
+		available := false
+
+		for _, condition := range builder.Object.Status.Conditions {
+			if condition.Type == v1.OperatorAvailable {
+				available = condition.Status == v1.ConditionTrue
+			}
+		}
+
+		lastObserved = fmt.Sprintf("version %s in state %s, available %t", latest.Version, latest.State, available)
+
+		return latest.State == v1.CompletedUpdate && available, nil
+	}, options...)
+
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          v1.GroupVersion.WithKind("ClusterVersion"),
+		Name:         builder.Definition.Name,
+		Wanted:       "latest update completed and available",
+		LastObserved: lastObserved,
+	})
+}
+
+// WaitUntilInstallCompleted waits for timeout duration or until the clusterversion of the cluster reached by
+// apiClient is completed and all of its clusteroperators are available. Pass the Settings of a hosted or spoke
+// cluster, built from its kubeconfig, to gate on the installation of that cluster.
+func WaitUntilInstallCompleted(apiClient *clients.Settings, timeout time.Duration, options ...await.WaitOption) error {
+	glog.V(100).Infof("Waiting for cluster installation to complete")
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return fmt.Errorf("failed to wait for installation, 'apiClient' parameter is nil")
+	}
+
+	startTime := time.Now()
+
+	var (
+		builder *Builder
+		err     error
+	)
+
+	err = await.Poll(timeout, func() (bool, error) {
+		builder, err = Pull(apiClient)
+
+		return err == nil, nil
+	}, options...)
+	if err != nil {
+		return fmt.Errorf("failed to pull clusterversion: %w", err)
+	}
+
+	if err := builder.WaitUntilCompleted(timeout-time.Since(startTime), options...); err != nil {
+		return err
+	}
+
+	return clusteroperator.WaitForAllAvailable(apiClient, timeout-time.Since(startTime), options...)
+}
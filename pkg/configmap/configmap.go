@@ -43,13 +43,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if name == "" {
 		glog.V(100).Infof("The name of the configmap is empty")
 
-		builder.errorMsg = "configmap 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "configmap 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the configmap is empty")
 
-		builder.errorMsg = "configmap 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "configmap 'nsname' cannot be empty")
 	}
 
 	glog.V(100).Infof(
@@ -82,13 +82,13 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	if name == "" {
 		glog.V(100).Infof("The name of the configmap is empty")
 
-		builder.errorMsg = "configmap 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "configmap 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the configmap is empty")
 
-		builder.errorMsg = "configmap 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "configmap 'nsname' cannot be empty")
 	}
 
 	return &builder
@@ -106,6 +106,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -130,6 +133,8 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -163,7 +168,7 @@ func (builder *Builder) WithData(data map[string]string) *Builder {
 		builder.Definition.Name, builder.Definition.Namespace, data)
 
 	if len(data) == 0 {
-		builder.errorMsg = "'data' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'data' cannot be empty")
 
 		return builder
 	}
@@ -183,15 +188,19 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -219,13 +228,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -0,0 +1,200 @@
+package console
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	operatorV1 "github.com/openshift/api/operator/v1"
+	"golang.org/x/exp/slices"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const consoleOperatorName = "cluster"
+
+// OperatorBuilder provides a struct for console.operator object from the cluster and a console.operator definition.
+type OperatorBuilder struct {
+	// console.operator definition, used to update the console.operator object.
+	Definition *operatorV1.Console
+	// Created console.operator object.
+	Object *operatorV1.Console
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	errorMsg  string
+}
+
+// PullOperator loads the existing console.operator into OperatorBuilder struct.
+func PullOperator(apiClient *clients.Settings) (*OperatorBuilder, error) {
+	glog.V(100).Infof("Pulling existing console.operator name: %s", consoleOperatorName)
+
+	builder := OperatorBuilder{
+		apiClient: apiClient,
+		Definition: &operatorV1.Console{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name: consoleOperatorName,
+			},
+		},
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("console.operator object %s doesn't exist", consoleOperatorName)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Exists checks whether the given console.operator exists.
+func (builder *OperatorBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof(
+		"Checking if console.operator %s exists",
+		builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Get returns console.operator object.
+func (builder *OperatorBuilder) Get() (*operatorV1.Console, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	consoleOperator := &operatorV1.Console{}
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name: builder.Definition.Name,
+	}, consoleOperator)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return consoleOperator, err
+}
+
+// Update renovates the existing console.operator object with the new definition in builder.
+func (builder *OperatorBuilder) Update() (*OperatorBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating the console.operator object %s",
+		builder.Definition.Name,
+	)
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err == nil {
+		builder.Object = builder.Definition
+	}
+
+	return builder, err
+}
+
+// WithPlugin enables the given console plugin in the console.operator definition.
+func (builder *OperatorBuilder) WithPlugin(pluginName string) *OperatorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Enabling console plugin %s in console.operator %s", pluginName, builder.Definition.Name)
+
+	if pluginName == "" {
+		glog.V(100).Infof("The console plugin name is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "console plugin 'pluginName' cannot be empty")
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	if !slices.Contains(builder.Definition.Spec.Plugins, pluginName) {
+		builder.Definition.Spec.Plugins = append(builder.Definition.Spec.Plugins, pluginName)
+	}
+
+	return builder
+}
+
+// WithoutPlugin disables the given console plugin in the console.operator definition.
+func (builder *OperatorBuilder) WithoutPlugin(pluginName string) *OperatorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Disabling console plugin %s in console.operator %s", pluginName, builder.Definition.Name)
+
+	if pluginName == "" {
+		glog.V(100).Infof("The console plugin name is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "console plugin 'pluginName' cannot be empty")
+	}
+
+	if builder.errorMsg != "" {
+		return builder
+	}
+
+	if index := slices.Index(builder.Definition.Spec.Plugins, pluginName); index != -1 {
+		builder.Definition.Spec.Plugins = slices.Delete(builder.Definition.Spec.Plugins, index, index+1)
+	}
+
+	return builder
+}
+
+// IsPluginEnabled checks if the given console plugin is enabled on the cluster.
+func (builder *OperatorBuilder) IsPluginEnabled(pluginName string) (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	glog.V(100).Infof("Checking if console plugin %s is enabled", pluginName)
+
+	if !builder.Exists() || builder.Object == nil {
+		return false, fmt.Errorf("console.operator object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return slices.Contains(builder.Object.Spec.Plugins, pluginName), nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *OperatorBuilder) validate() (bool, error) {
+	resourceCRD := "Console.Operator"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,327 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// pluginGVR is the resource of the console plugins. The console API is not vendored, the plugins are handled as
+// unstructured objects through the dynamic client.
+var pluginGVR = schema.GroupVersionResource{
+	Group:    "console.openshift.io",
+	Version:  "v1",
+	Resource: "consoleplugins",
+}
+
+// PluginBuilder provides a struct for consoleplugin object from the cluster and a consoleplugin definition.
+type PluginBuilder struct {
+	// consoleplugin definition, used to create the consoleplugin object.
+	Definition *unstructured.Unstructured
+	// Created consoleplugin object.
+	Object *unstructured.Unstructured
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// Used in functions that define or mutate the consoleplugin definition. errorMsg is processed before the
+	// consoleplugin object is created.
+	errorMsg string
+}
+
+// NewPluginBuilder creates a new instance of PluginBuilder for a plugin served by the given port of a service.
+func NewPluginBuilder(
+	apiClient *clients.Settings,
+	name, displayName, serviceName, serviceNamespace string,
+	servicePort int32) *PluginBuilder {
+	glog.V(100).Infof(
+		"Initializing new consoleplugin structure with the following params: "+
+			"name: %s, displayName: %s, service: %s/%s, port: %d",
+		name, displayName, serviceNamespace, serviceName, servicePort)
+
+	builder := PluginBuilder{
+		apiClient:  apiClient,
+		Definition: newUnstructured(pluginGVR, "ConsolePlugin", name),
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the consoleplugin is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "consoleplugin 'name' cannot be empty")
+	}
+
+	if serviceName == "" || serviceNamespace == "" {
+		glog.V(100).Infof("The service of the consoleplugin is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "consoleplugin service name and namespace cannot be empty")
+	}
+
+	if servicePort <= 0 {
+		glog.V(100).Infof("The service port of the consoleplugin is invalid: %d", servicePort)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "consoleplugin 'servicePort' must be positive")
+	}
+
+	if builder.errorMsg != "" {
+		return &builder
+	}
+
+	builder.setSpecField(displayName, "displayName")
+	builder.setSpecField("Service", "backend", "type")
+	builder.setSpecField(serviceName, "backend", "service", "name")
+	builder.setSpecField(serviceNamespace, "backend", "service", "namespace")
+	builder.setSpecField(int64(servicePort), "backend", "service", "port")
+	builder.setSpecField("/", "backend", "service", "basePath")
+
+	return &builder
+}
+
+// PullPlugin loads an existing consoleplugin into PluginBuilder struct.
+func PullPlugin(apiClient *clients.Settings, name string) (*PluginBuilder, error) {
+	glog.V(100).Infof("Pulling existing consoleplugin name: %s", name)
+
+	builder := PluginBuilder{
+		apiClient:  apiClient,
+		Definition: newUnstructured(pluginGVR, "ConsolePlugin", name),
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the consoleplugin is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "consoleplugin 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("consoleplugin object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithBasePath sets the path of the plugin assets on the service. The default is the root path.
+func (builder *PluginBuilder) WithBasePath(basePath string) *PluginBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting base path %s of consoleplugin %s", basePath, builder.Definition.GetName())
+
+	if basePath == "" {
+		glog.V(100).Infof("The base path of the consoleplugin is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "consoleplugin 'basePath' cannot be empty")
+
+		return builder
+	}
+
+	builder.setSpecField(basePath, "backend", "service", "basePath")
+
+	return builder
+}
+
+// Get returns the consoleplugin object.
+func (builder *PluginBuilder) Get() (*unstructured.Unstructured, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return builder.apiClient.Resource(pluginGVR).Get(
+		context.TODO(), builder.Definition.GetName(), metaV1.GetOptions{})
+}
+
+// Exists checks whether the given consoleplugin exists.
+func (builder *PluginBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if consoleplugin %s exists", builder.Definition.GetName())
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a consoleplugin in the cluster and stores the created object in struct.
+func (builder *PluginBuilder) Create() (*PluginBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating the consoleplugin %s", builder.Definition.GetName())
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	var err error
+	builder.Object, err = builder.apiClient.Resource(pluginGVR).Create(
+		context.TODO(), builder.Definition, metaV1.CreateOptions{})
+	if err != nil {
+		return builder, err
+	}
+
+	builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+
+	return builder, nil
+}
+
+// Update renovates the existing consoleplugin object with the definition in builder.
+func (builder *PluginBuilder) Update() (*PluginBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating the consoleplugin %s", builder.Definition.GetName())
+
+	if !builder.Exists() || builder.Object == nil {
+		return builder, fmt.Errorf("consoleplugin object %s doesn't exist", builder.Definition.GetName())
+	}
+
+	builder.Definition.SetResourceVersion(builder.Object.GetResourceVersion())
+
+	var err error
+	builder.Object, err = builder.apiClient.Resource(pluginGVR).Update(
+		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
+
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
+}
+
+// Delete removes the consoleplugin from the cluster. The plugin is not disabled in the console operator config.
+func (builder *PluginBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting the consoleplugin %s", builder.Definition.GetName())
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil
+	}
+
+	err := builder.apiClient.Resource(pluginGVR).Delete(
+		context.TODO(), builder.Definition.GetName(), metaV1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("can not delete consoleplugin %s: %w", builder.Definition.GetName(), err)
+	}
+
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
+	builder.Object = nil
+
+	return nil
+}
+
+// Enable adds the plugin to the plugins enabled in the console operator config.
+func (builder *PluginBuilder) Enable() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Enabling consoleplugin %s in the console operator config", builder.Definition.GetName())
+
+	consoleOperator, err := PullOperator(builder.apiClient)
+	if err != nil {
+		return err
+	}
+
+	_, err = consoleOperator.WithPlugin(builder.Definition.GetName()).Update()
+
+	return err
+}
+
+// Disable removes the plugin from the plugins enabled in the console operator config.
+func (builder *PluginBuilder) Disable() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Disabling consoleplugin %s in the console operator config", builder.Definition.GetName())
+
+	consoleOperator, err := PullOperator(builder.apiClient)
+	if err != nil {
+		return err
+	}
+
+	_, err = consoleOperator.WithoutPlugin(builder.Definition.GetName()).Update()
+
+	return err
+}
+
+// IsEnabled checks if the plugin is enabled in the console operator config.
+func (builder *PluginBuilder) IsEnabled() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	consoleOperator, err := PullOperator(builder.apiClient)
+	if err != nil {
+		return false, err
+	}
+
+	return consoleOperator.IsPluginEnabled(builder.Definition.GetName())
+}
+
+// setSpecField sets the given field of the spec of the definition.
+func (builder *PluginBuilder) setSpecField(value interface{}, fields ...string) {
+	err := unstructured.SetNestedField(builder.Definition.Object, value, append([]string{"spec"}, fields...)...)
+	if err != nil {
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
+	}
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PluginBuilder) validate() (bool, error) {
+	resourceCRD := "ConsolePlugin"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, errors.New(builder.errorMsg)
+	}
+
+	return true, nil
+}
+
+// newUnstructured returns an empty cluster scoped object of the given resource and kind.
+func newUnstructured(gvr schema.GroupVersionResource, kind, name string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	object.SetGroupVersionKind(gvr.GroupVersion().WithKind(kind))
+	object.SetName(name)
+
+	return object
+}
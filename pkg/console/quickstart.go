@@ -0,0 +1,295 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// quickStartGVR is the resource of the console quick starts, handled as unstructured objects like the plugins.
+var quickStartGVR = schema.GroupVersionResource{
+	Group:    "console.openshift.io",
+	Version:  "v1",
+	Resource: "consolequickstarts",
+}
+
+// QuickStartBuilder provides a struct for consolequickstart object from the cluster and a consolequickstart
+// definition.
+type QuickStartBuilder struct {
+	// consolequickstart definition, used to create the consolequickstart object.
+	Definition *unstructured.Unstructured
+	// Created consolequickstart object.
+	Object *unstructured.Unstructured
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// Used in functions that define or mutate the consolequickstart definition. errorMsg is processed before the
+	// consolequickstart object is created.
+	errorMsg string
+}
+
+// NewQuickStartBuilder creates a new instance of QuickStartBuilder. At least one task must be added with WithTask
+// before the quick start is created.
+func NewQuickStartBuilder(
+	apiClient *clients.Settings,
+	name, displayName, description, introduction string,
+	durationMinutes int64) *QuickStartBuilder {
+	glog.V(100).Infof(
+		"Initializing new consolequickstart structure with the following params: "+
+			"name: %s, displayName: %s, durationMinutes: %d",
+		name, displayName, durationMinutes)
+
+	builder := QuickStartBuilder{
+		apiClient:  apiClient,
+		Definition: newUnstructured(quickStartGVR, "ConsoleQuickStart", name),
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the consolequickstart is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "consolequickstart 'name' cannot be empty")
+	}
+
+	if displayName == "" {
+		glog.V(100).Infof("The display name of the consolequickstart is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "consolequickstart 'displayName' cannot be empty")
+	}
+
+	if durationMinutes <= 0 {
+		glog.V(100).Infof("The duration of the consolequickstart is invalid: %d", durationMinutes)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "consolequickstart 'durationMinutes' must be positive")
+	}
+
+	if builder.errorMsg != "" {
+		return &builder
+	}
+
+	builder.setSpecField(displayName, "displayName")
+	builder.setSpecField(description, "description")
+	builder.setSpecField(introduction, "introduction")
+	builder.setSpecField(durationMinutes, "durationMinutes")
+
+	return &builder
+}
+
+// PullQuickStart loads an existing consolequickstart into QuickStartBuilder struct.
+func PullQuickStart(apiClient *clients.Settings, name string) (*QuickStartBuilder, error) {
+	glog.V(100).Infof("Pulling existing consolequickstart name: %s", name)
+
+	builder := QuickStartBuilder{
+		apiClient:  apiClient,
+		Definition: newUnstructured(quickStartGVR, "ConsoleQuickStart", name),
+	}
+
+	if name == "" {
+		glog.V(100).Infof("The name of the consolequickstart is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "consolequickstart 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("consolequickstart object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithTask appends a task with the given title and description to the quick start.
+func (builder *QuickStartBuilder) WithTask(title, description string) *QuickStartBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding task %s to consolequickstart %s", title, builder.Definition.GetName())
+
+	if title == "" {
+		glog.V(100).Infof("The title of the consolequickstart task is empty")
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "consolequickstart task 'title' cannot be empty")
+
+		return builder
+	}
+
+	tasks, _, err := unstructured.NestedSlice(builder.Definition.Object, "spec", "tasks")
+	if err != nil {
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
+
+		return builder
+	}
+
+	tasks = append(tasks, map[string]interface{}{"title": title, "description": description})
+	builder.setSpecField(tasks, "tasks")
+
+	return builder
+}
+
+// WithConclusion sets the text shown once all the tasks of the quick start are completed.
+func (builder *QuickStartBuilder) WithConclusion(conclusion string) *QuickStartBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Setting conclusion of consolequickstart %s", builder.Definition.GetName())
+
+	builder.setSpecField(conclusion, "conclusion")
+
+	return builder
+}
+
+// Get returns the consolequickstart object.
+func (builder *QuickStartBuilder) Get() (*unstructured.Unstructured, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return builder.apiClient.Resource(quickStartGVR).Get(
+		context.TODO(), builder.Definition.GetName(), metaV1.GetOptions{})
+}
+
+// Exists checks whether the given consolequickstart exists.
+func (builder *QuickStartBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof("Checking if consolequickstart %s exists", builder.Definition.GetName())
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a consolequickstart in the cluster and stores the created object in struct.
+func (builder *QuickStartBuilder) Create() (*QuickStartBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Creating the consolequickstart %s", builder.Definition.GetName())
+
+	tasks, _, _ := unstructured.NestedSlice(builder.Definition.Object, "spec", "tasks")
+	if len(tasks) == 0 {
+		glog.V(100).Infof("The consolequickstart %s has no tasks", builder.Definition.GetName())
+
+		return builder, fmt.Errorf("consolequickstart %s must have at least one task", builder.Definition.GetName())
+	}
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	var err error
+	builder.Object, err = builder.apiClient.Resource(quickStartGVR).Create(
+		context.TODO(), builder.Definition, metaV1.CreateOptions{})
+	if err != nil {
+		return builder, err
+	}
+
+	builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+
+	return builder, nil
+}
+
+// Update renovates the existing consolequickstart object with the definition in builder.
+func (builder *QuickStartBuilder) Update() (*QuickStartBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating the consolequickstart %s", builder.Definition.GetName())
+
+	if !builder.Exists() || builder.Object == nil {
+		return builder, fmt.Errorf("consolequickstart object %s doesn't exist", builder.Definition.GetName())
+	}
+
+	builder.Definition.SetResourceVersion(builder.Object.GetResourceVersion())
+
+	var err error
+	builder.Object, err = builder.apiClient.Resource(quickStartGVR).Update(
+		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
+
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
+}
+
+// Delete removes the consolequickstart from the cluster.
+func (builder *QuickStartBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Deleting the consolequickstart %s", builder.Definition.GetName())
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil
+	}
+
+	err := builder.apiClient.Resource(quickStartGVR).Delete(
+		context.TODO(), builder.Definition.GetName(), metaV1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("can not delete consolequickstart %s: %w", builder.Definition.GetName(), err)
+	}
+
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
+	builder.Object = nil
+
+	return nil
+}
+
+// setSpecField sets the given field of the spec of the definition.
+func (builder *QuickStartBuilder) setSpecField(value interface{}, fields ...string) {
+	err := unstructured.SetNestedField(builder.Definition.Object, value, append([]string{"spec"}, fields...)...)
+	if err != nil {
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
+	}
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *QuickStartBuilder) validate() (bool, error) {
+	resourceCRD := "ConsoleQuickStart"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, errors.New(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -6,13 +6,13 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "k8s.io/api/apps/v1"
 	coreV1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // Builder provides struct for daemonset object containing connection to the cluster and the daemonset definitions.
@@ -65,19 +65,19 @@ func NewBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the daemonset is empty")
 
-		builder.errorMsg = "daemonset 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "daemonset 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the daemonset is empty")
 
-		builder.errorMsg = "daemonset 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "daemonset 'namespace' cannot be empty")
 	}
 
 	if len(labels) == 0 {
 		glog.V(100).Infof("There are no labels for the daemonset")
 
-		builder.errorMsg = "daemonset 'labels' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "daemonset 'labels' cannot be empty")
 	}
 
 	return &builder
@@ -98,11 +98,11 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		builder.errorMsg = "daemonset 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "daemonset 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "daemonset 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "daemonset 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -126,7 +126,7 @@ func (builder *Builder) WithNodeSelector(selector map[string]string) *Builder {
 	if len(selector) == 0 {
 		glog.V(100).Infof("The nodeselector is empty")
 
-		builder.errorMsg = "cannot accept empty map as nodeselector"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot accept empty map as nodeselector")
 	}
 
 	if builder.errorMsg != "" {
@@ -150,7 +150,7 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []coreV1.Container) *
 	if len(specs) == 0 {
 		glog.V(100).Infof("The container specs are empty")
 
-		builder.errorMsg = "cannot accept empty list as container specs"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot accept empty list as container specs")
 	}
 
 	if builder.errorMsg != "" {
@@ -176,15 +176,19 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -203,6 +207,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -219,8 +226,13 @@ func (builder *Builder) Update() (*Builder, error) {
 	var err error
 	builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Update(
 		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // Delete removes the daemonset.
@@ -243,13 +255,16 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
 }
 
 // CreateAndWaitUntilReady creates a daemonset in the cluster and waits until the daemonset is available.
-func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder, error) {
+func (builder *Builder) CreateAndWaitUntilReady(
+	timeout time.Duration, options ...await.WaitOption) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -262,8 +277,10 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 		return nil, fmt.Errorf(err.Error())
 	}
 
+	lastObserved := "condition Available missing"
+
 	// Polls every retryInterval to determine if daemonset is available.
-	err = wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err = await.Poll(timeout, func() (bool, error) {
 		builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
 			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
 
@@ -273,23 +290,25 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 
 		for _, condition := range builder.Object.Status.Conditions {
 			if condition.Type == "Available" {
+				lastObserved = fmt.Sprintf("condition Available %s", condition.Status)
+
 				return condition.Status == "True", nil
 			}
 		}
 
 		return false, err
 
-	})
+	}, withRetryInterval(options)...)
 
 	if err == nil {
 		return builder, nil
 	}
 
-	return nil, err
+	return nil, builder.withTimeoutDetails(err, "condition Available True", lastObserved)
 }
 
 // DeleteAndWait deletes a daemonset and waits until it is removed from the cluster.
-func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+func (builder *Builder) DeleteAndWait(timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -302,7 +321,7 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 	}
 
 	// Polls the daemonset every retryInterval until it's removed.
-	return wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 		_, err := builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
 			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
 		if k8serrors.IsNotFound(err) {
@@ -311,7 +330,9 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		}
 
 		return false, nil
-	})
+	}, withRetryInterval(options)...)
+
+	return builder.withTimeoutDetails(err, "deletion", "object still present")
 }
 
 // Exists checks whether the given daemonset exists.
@@ -331,7 +352,7 @@ func (builder *Builder) Exists() bool {
 }
 
 // IsReady waits for the daemonset to reach expected number of pods in Ready state.
-func (builder *Builder) IsReady(timeout time.Duration) bool {
+func (builder *Builder) IsReady(timeout time.Duration, options ...await.WaitOption) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -340,7 +361,7 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 		"timeout %s exceeded", builder.Definition.Name, builder.Definition.Namespace, timeout.String())
 
 	// Polls every retryInterval to determine if daemonset is available.
-	err := wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 		if !builder.Exists() {
 			return false, fmt.Errorf("daemonset %s is not present on cluster", builder.Object.Name)
 		}
@@ -363,11 +384,28 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 
 		return false, err
 
-	})
+	}, withRetryInterval(options)...)
 
 	return err == nil
 }
 
+// withRetryInterval returns the given wait options preceded by the retryInterval of the daemonset waits, so that
+// an interval passed by the caller takes precedence.
+func withRetryInterval(options []await.WaitOption) []await.WaitOption {
+	return append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+}
+
+// withTimeoutDetails converts a wait timeout into an await.WaitTimeoutError referencing the daemonset.
+func (builder *Builder) withTimeoutDetails(err error, wanted, lastObserved string) error {
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          v1.SchemeGroupVersion.WithKind("DaemonSet"),
+		Name:         builder.Definition.Name,
+		Namespace:    builder.Definition.Namespace,
+		Wanted:       wanted,
+		LastObserved: lastObserved,
+	})
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
@@ -382,13 +420,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -9,6 +9,7 @@ import (
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "k8s.io/api/apps/v1"
@@ -16,7 +17,6 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // Builder provides struct for deployment object containing connection to the cluster and the deployment definitions.
@@ -67,19 +67,19 @@ func NewBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the deployment is empty")
 
-		builder.errorMsg = "deployment 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "deployment 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the deployment is empty")
 
-		builder.errorMsg = "deployment 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "deployment 'namespace' cannot be empty")
 	}
 
 	if len(labels) == 0 {
 		glog.V(100).Infof("There are no labels for the deployment")
 
-		builder.errorMsg = "deployment 'labels' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "deployment 'labels' cannot be empty")
 	}
 
 	return &builder
@@ -100,11 +100,11 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		builder.errorMsg = "deployment 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "deployment 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "deployment 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "deployment 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -156,7 +156,7 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []coreV1.Container) *
 	if len(specs) == 0 {
 		glog.V(100).Infof("The container specs are empty")
 
-		builder.errorMsg = "cannot accept empty list as container specs"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot accept empty list as container specs")
 	}
 
 	if builder.errorMsg != "" {
@@ -181,13 +181,14 @@ func (builder *Builder) WithSecondaryNetwork(networks []*multus.NetworkSelection
 	glog.V(100).Infof("Applying secondary networks %v to deployment %s", networks, builder.Definition.Name)
 
 	if len(networks) == 0 {
-		builder.errorMsg = "can not apply empty networks list"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not apply empty networks list")
 	}
 
 	netAnnotation, err := json.Marshal(networks)
 
 	if err != nil {
-		builder.errorMsg = fmt.Sprintf("error to unmarshal networks annotation due to: %s", err.Error())
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("error to unmarshal networks annotation due to: %s", err.Error()))
 	}
 
 	if builder.errorMsg != "" {
@@ -250,7 +251,7 @@ func (builder *Builder) WithSecurityContext(securityContext *coreV1.PodSecurityC
 	if securityContext == nil {
 		glog.V(100).Infof("The 'securityContext' of the deployment is empty")
 
-		builder.errorMsg = "'securityContext' parameter is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'securityContext' parameter is empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -273,7 +274,7 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 	if labelKey == "" {
 		glog.V(100).Infof("The 'labelKey' of the deployment is empty")
 
-		builder.errorMsg = "can not apply empty labelKey"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not apply empty labelKey")
 	}
 
 	if builder.errorMsg != "" {
@@ -301,15 +302,19 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -328,6 +333,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -344,8 +352,13 @@ func (builder *Builder) Update() (*Builder, error) {
 	var err error
 	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Update(
 		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // Delete removes a deployment.
@@ -368,13 +381,16 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
 }
 
 // CreateAndWaitUntilReady creates a deployment in the cluster and waits until the deployment is available.
-func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder, error) {
+func (builder *Builder) CreateAndWaitUntilReady(
+	timeout time.Duration, options ...await.WaitOption) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -386,7 +402,7 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 		return nil, fmt.Errorf(err.Error())
 	}
 
-	if builder.IsReady(timeout) {
+	if builder.IsReady(timeout, options...) {
 		return builder, nil
 	}
 
@@ -396,7 +412,7 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 }
 
 // IsReady periodically checks if deployment is in ready status.
-func (builder *Builder) IsReady(timeout time.Duration) bool {
+func (builder *Builder) IsReady(timeout time.Duration, options ...await.WaitOption) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -408,7 +424,7 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 		return false
 	}
 
-	err := wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 
 		var err error
 		builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
@@ -423,13 +439,13 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 		}
 
 		return false, nil
-	})
+	}, options...)
 
 	return err == nil
 }
 
 // DeleteAndWait deletes a deployment and waits until it is removed from the cluster.
-func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+func (builder *Builder) DeleteAndWait(timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -442,7 +458,7 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 	}
 
 	// Polls the deployment every second until it's removed.
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 		_, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
 			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
 		if k8serrors.IsNotFound(err) {
@@ -451,7 +467,9 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		}
 
 		return false, nil
-	})
+	}, options...)
+
+	return builder.withTimeoutDetails(err, "deletion", "object still present")
 }
 
 // Exists checks whether the given deployment exists.
@@ -479,6 +497,12 @@ func GetGVR() schema.GroupVersionResource {
 func List(apiClient *clients.Settings, nsname string, options metaV1.ListOptions) ([]*Builder, error) {
 	glog.V(100).Infof("Listing deployments in the namespace %s with the options %v", nsname, options)
 
+	if apiClient == nil {
+		glog.V(100).Infof("deployments 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list deployments, 'apiClient' parameter is empty")
+	}
+
 	if nsname == "" {
 		glog.V(100).Infof("deployment 'nsname' parameter can not be empty")
 
@@ -511,7 +535,8 @@ func List(apiClient *clients.Settings, nsname string, options metaV1.ListOptions
 
 // WaitUntilCondition waits for the duration of the defined timeout or until the
 // deployment gets to a specific condition.
-func (builder *Builder) WaitUntilCondition(condition v1.DeploymentConditionType, timeout time.Duration) error {
+func (builder *Builder) WaitUntilCondition(
+	condition v1.DeploymentConditionType, timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -523,21 +548,43 @@ func (builder *Builder) WaitUntilCondition(condition v1.DeploymentConditionType,
 		return fmt.Errorf("cannot wait for deployment condition because it does not exist")
 	}
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
 		updateDeployment, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
 			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("condition %s missing", condition)
+
 		for _, cond := range updateDeployment.Status.Conditions {
-			if cond.Type == condition && cond.Status == coreV1.ConditionTrue {
+			if cond.Type != condition {
+				continue
+			}
+
+			lastObserved = fmt.Sprintf("condition %s %s", condition, cond.Status)
+
+			if cond.Status == coreV1.ConditionTrue {
 				return true, nil
 			}
 		}
 
 		return false, nil
+	}, options...)
+
+	return builder.withTimeoutDetails(err, fmt.Sprintf("condition %s True", condition), lastObserved)
+}
 
+// withTimeoutDetails converts a wait timeout into an await.WaitTimeoutError referencing the deployment.
+func (builder *Builder) withTimeoutDetails(err error, wanted, lastObserved string) error {
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          v1.SchemeGroupVersion.WithKind("Deployment"),
+		Name:         builder.Definition.Name,
+		Namespace:    builder.Definition.Namespace,
+		Wanted:       wanted,
+		LastObserved: lastObserved,
 	})
 }
 
@@ -555,13 +602,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
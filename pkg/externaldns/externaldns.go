@@ -0,0 +1,256 @@
+package externaldns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// RecordType is the type of a DNS record.
+type RecordType string
+
+const (
+	// RecordTypeA is an IPv4 address record.
+	RecordTypeA RecordType = "A"
+	// RecordTypeAAAA is an IPv6 address record.
+	RecordTypeAAAA RecordType = "AAAA"
+	// RecordTypeCNAME is an alias record.
+	RecordTypeCNAME RecordType = "CNAME"
+	// DefaultTTL is the time to live in seconds of records that do not set one.
+	DefaultTTL = 300
+	// nsupdateTimeout bounds a single nsupdate invocation.
+	nsupdateTimeout = time.Minute
+)
+
+// Record is a DNS record in a lab DNS server.
+type Record struct {
+	// Name is the fully qualified name of the record, e.g. api.hosted.example.com.
+	Name  string
+	Type  RecordType
+	Value string
+	// TTL is the time to live of the record in seconds. DefaultTTL is used if it is not positive.
+	TTL int
+}
+
+// String returns the record in zone file notation.
+func (record Record) String() string {
+	return fmt.Sprintf("%s %d %s %s", fqdn(record.Name), record.ttl(), record.Type, record.Value)
+}
+
+// RecordManager creates and removes records in a DNS server. It allows plugging in DNS servers that are not
+// updated through RFC2136.
+type RecordManager interface {
+	AddRecord(record Record) error
+	RemoveRecord(record Record) error
+}
+
+// NSUpdateManager is a RecordManager sending RFC2136 dynamic updates through the nsupdate command.
+type NSUpdateManager struct {
+	server  string
+	zone    string
+	keyFile string
+}
+
+// NewNSUpdateManager creates a new instance of NSUpdateManager updating the given zone on the given server. The
+// server may include a port, e.g. 10.1.1.1 53. If keyFile is not empty, updates are signed with the TSIG key it
+// contains.
+func NewNSUpdateManager(server, zone, keyFile string) (*NSUpdateManager, error) {
+	glog.V(100).Infof("Initializing new nsupdate manager for zone %s on server %s", zone, server)
+
+	if server == "" {
+		glog.V(100).Infof("The DNS server is empty")
+
+		return nil, fmt.Errorf("failed to initialize nsupdate manager, 'server' parameter is empty")
+	}
+
+	if zone == "" {
+		glog.V(100).Infof("The DNS zone is empty")
+
+		return nil, fmt.Errorf("failed to initialize nsupdate manager, 'zone' parameter is empty")
+	}
+
+	if _, err := exec.LookPath("nsupdate"); err != nil {
+		return nil, fmt.Errorf("failed to initialize nsupdate manager: %w", err)
+	}
+
+	return &NSUpdateManager{server: server, zone: zone, keyFile: keyFile}, nil
+}
+
+// AddRecord adds the given record to the zone.
+func (manager *NSUpdateManager) AddRecord(record Record) error {
+	glog.V(100).Infof("Adding DNS record %s", record)
+
+	if err := validateRecord(record); err != nil {
+		return err
+	}
+
+	return manager.update(fmt.Sprintf("update add %s", record))
+}
+
+// RemoveRecord removes the given record from the zone. Removing a record that does not exist is not an error.
+func (manager *NSUpdateManager) RemoveRecord(record Record) error {
+	glog.V(100).Infof("Removing DNS record %s", record)
+
+	if err := validateRecord(record); err != nil {
+		return err
+	}
+
+	return manager.update(fmt.Sprintf("update delete %s %s %s", fqdn(record.Name), record.Type, record.Value))
+}
+
+// update sends a single dynamic update containing the given update command.
+func (manager *NSUpdateManager) update(command string) error {
+	script := fmt.Sprintf("server %s\nzone %s\n%s\nsend\n", manager.server, fqdn(manager.zone), command)
+
+	var args []string
+	if manager.keyFile != "" {
+		args = append(args, "-k", manager.keyFile)
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), nsupdateTimeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+
+	nsupdate := exec.CommandContext(ctx, "nsupdate", args...)
+	nsupdate.Stdin = strings.NewReader(script)
+	nsupdate.Stderr = &stderr
+
+	if err := nsupdate.Run(); err != nil {
+		return fmt.Errorf("nsupdate failed for %q: %w: %s", command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+// HostedClusterRecords returns the api, api-int and wildcard apps records of a hosted cluster with the given name
+// and base domain. The record type is derived from the IP version of each address.
+func HostedClusterRecords(clusterName, baseDomain, apiAddress, ingressAddress string) ([]Record, error) {
+	glog.V(100).Infof("Building DNS records of hosted cluster %s.%s", clusterName, baseDomain)
+
+	if clusterName == "" || baseDomain == "" {
+		glog.V(100).Infof("The cluster name or base domain is empty")
+
+		return nil, fmt.Errorf("cluster name and base domain cannot be empty")
+	}
+
+	apiType, err := addressRecordType(apiAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ingressType, err := addressRecordType(ingressAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterDomain := fmt.Sprintf("%s.%s", clusterName, strings.TrimSuffix(baseDomain, "."))
+
+	return []Record{
+		{Name: "api." + clusterDomain, Type: apiType, Value: apiAddress},
+		{Name: "api-int." + clusterDomain, Type: apiType, Value: apiAddress},
+		{Name: "*.apps." + clusterDomain, Type: ingressType, Value: ingressAddress},
+	}, nil
+}
+
+// AddRecords adds all the given records and returns the aggregated errors of the records that failed.
+func AddRecords(manager RecordManager, records ...Record) error {
+	if manager == nil {
+		glog.V(100).Infof("The record manager is nil")
+
+		return fmt.Errorf("failed to add DNS records, 'manager' parameter is nil")
+	}
+
+	var errs []error
+
+	for _, record := range records {
+		if err := manager.AddRecord(record); err != nil {
+			errs = append(errs, fmt.Errorf("failed to add record %s: %w", record, err))
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// RemoveRecords removes all the given records and returns the aggregated errors of the records that failed.
+func RemoveRecords(manager RecordManager, records ...Record) error {
+	if manager == nil {
+		glog.V(100).Infof("The record manager is nil")
+
+		return fmt.Errorf("failed to remove DNS records, 'manager' parameter is nil")
+	}
+
+	var errs []error
+
+	for _, record := range records {
+		if err := manager.RemoveRecord(record); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove record %s: %w", record, err))
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// validateRecord checks that the record has a name, a supported type and a value matching the type.
+func validateRecord(record Record) error {
+	if record.Name == "" || record.Value == "" {
+		return fmt.Errorf("DNS record name and value cannot be empty")
+	}
+
+	switch record.Type {
+	case RecordTypeA, RecordTypeAAAA:
+		addressType, err := addressRecordType(record.Value)
+		if err != nil {
+			return err
+		}
+
+		if addressType != record.Type {
+			return fmt.Errorf("DNS record %s has type %s but value %s is an %s address",
+				record.Name, record.Type, record.Value, addressType)
+		}
+	case RecordTypeCNAME:
+	default:
+		return fmt.Errorf("not acceptable DNS record type %q", record.Type)
+	}
+
+	return nil
+}
+
+// addressRecordType returns the address record type matching the IP version of address.
+func addressRecordType(address string) (RecordType, error) {
+	parsedIP := net.ParseIP(address)
+	if parsedIP == nil {
+		return "", fmt.Errorf("%q is not a valid IP address", address)
+	}
+
+	if parsedIP.To4() != nil {
+		return RecordTypeA, nil
+	}
+
+	return RecordTypeAAAA, nil
+}
+
+// fqdn returns name with a trailing dot.
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
+
+// ttl returns the time to live of the record, or DefaultTTL if it is not positive.
+func (record Record) ttl() int {
+	if record.TTL > 0 {
+		return record.TTL
+	}
+
+	return DefaultTTL
+}
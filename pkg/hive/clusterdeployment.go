@@ -71,31 +71,31 @@ func NewABMClusterDeploymentBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterdeployment 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterdeployment 'namespace' cannot be empty")
 	}
 
 	if clusterName == "" {
 		glog.V(100).Infof("The clusterName of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'clusterName' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterdeployment 'clusterName' cannot be empty")
 	}
 
 	if baseDomain == "" {
 		glog.V(100).Infof("The baseDomain of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'baseDomain' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterdeployment 'baseDomain' cannot be empty")
 	}
 
 	if clusterInstallRef == "" {
 		glog.V(100).Infof("The clusterInstallRef of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'clusterInstallRef' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterdeployment 'clusterInstallRef' cannot be empty")
 	}
 
 	return &builder
@@ -116,13 +116,14 @@ func (builder *ClusterDeploymentBuilder) WithAdditionalAgentSelectorLabels(
 	if builder.Definition.Spec.Platform.AgentBareMetal == nil {
 		glog.V(100).Infof("The clusterdeployment platform is not agentBareMetal")
 
-		builder.errorMsg = "clusterdeployment type must be AgentBareMetal to use agentSelector"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "clusterdeployment type must be AgentBareMetal to use agentSelector")
 	}
 
 	if len(agentSelector) == 0 {
 		glog.V(100).Infof("The clusterdeployment agentSelector is empty")
 
-		builder.errorMsg = "agentSelector cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "agentSelector cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -183,6 +184,12 @@ func ListClusterDeploymentsInAllNamespaces(
 	options goclient.ListOption) ([]*ClusterDeploymentBuilder, error) {
 	glog.V(100).Infof("Listing all clusterdeployments with the options %v", options)
 
+	if apiClient == nil {
+		glog.V(100).Infof("clusterdeployments 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list clusterdeployments, 'apiClient' parameter is empty")
+	}
+
 	clusterDeployments := new(hiveV1.ClusterDeploymentList)
 	err := apiClient.List(context.TODO(), clusterDeployments, options)
 
@@ -225,13 +232,13 @@ func PullClusterDeployment(apiClient *clients.Settings, name, nsname string) (*C
 	if name == "" {
 		glog.V(100).Infof("The name of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterdeployment 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterdeployment 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -274,15 +281,19 @@ func (builder *ClusterDeploymentBuilder) WithOptions(
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -380,13 +391,14 @@ func (builder *ClusterDeploymentBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
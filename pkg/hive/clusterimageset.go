@@ -46,19 +46,19 @@ func NewClusterImageSetBuilder(apiClient *clients.Settings, name, releaseImage s
 	if apiClient == nil {
 		glog.V(100).Infof("The apiClient is nil")
 
-		builder.errorMsg = "clusterimageset cannot have nil apiClient"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterimageset cannot have nil apiClient")
 	}
 
 	if name == "" {
 		glog.V(100).Infof("The name of the clusterimageset is empty")
 
-		builder.errorMsg = "clusterimageset 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterimageset 'name' cannot be empty")
 	}
 
 	if releaseImage == "" {
 		glog.V(100).Infof("The releaseImage of the clusterimageset is empty")
 
-		builder.errorMsg = "clusterimageset 'releaseImage' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterimageset 'releaseImage' cannot be empty")
 	}
 
 	return &builder
@@ -76,7 +76,7 @@ func (builder *ClusterImageSetBuilder) WithReleaseImage(image string) *ClusterIm
 	if image == "" {
 		glog.V(100).Infof("The clusterimageset releaseImage is empty")
 
-		builder.errorMsg = "cannot set releaseImage to empty string"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot set releaseImage to empty string")
 	}
 
 	if builder.errorMsg != "" {
@@ -99,15 +99,19 @@ func (builder *ClusterImageSetBuilder) WithOptions(
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -128,7 +132,7 @@ func PullClusterImageSet(apiClient *clients.Settings, name string) (*ClusterImag
 	}
 
 	if name == "" {
-		builder.errorMsg = "clusterimageset 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterimageset 'name' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -266,13 +270,14 @@ func (builder *ClusterImageSetBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -0,0 +1,248 @@
+package hugepages
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"github.com/openshift-kni/eco-goinfra/pkg/nto"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	v2 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/performanceprofile/v2"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// readerPodPrefix is the name prefix of the pods reading the hugepages sysfs tree.
+	readerPodPrefix = "hugepages-reader"
+	// sysfsReadCommand prints every per-NUMA nr_hugepages file together with its value.
+	sysfsReadCommand = "for f in /sys/devices/system/node/node*/hugepages/hugepages-*/nr_hugepages; " +
+		"do echo \"$f $(cat $f)\"; done"
+)
+
+// sysfsLineRegex extracts the NUMA node, page size in kB and page count from a sysfsReadCommand output line.
+var sysfsLineRegex = regexp.MustCompile(`node(\d+)/hugepages/hugepages-(\d+)kB/nr_hugepages (\d+)`)
+
+// pageSizes maps the PerformanceProfile hugepage sizes to their size in kB.
+var pageSizes = map[v2.HugePageSize]int64{
+	"2M": 2 * 1024,
+	"1G": 1024 * 1024,
+}
+
+// NodeAllocation contains the hugepages allocated on a node, keyed by PerformanceProfile page size.
+type NodeAllocation struct {
+	NodeName string
+	// Capacity is the number of pages per size reported in the node status.
+	Capacity map[v2.HugePageSize]int64
+	// PerNUMA is the number of pages per NUMA node and size read from sysfs.
+	PerNUMA map[int32]map[v2.HugePageSize]int64
+}
+
+// Mismatch describes a difference between the expected and the allocated hugepages of a node.
+type Mismatch struct {
+	NodeName string
+	Size     v2.HugePageSize
+	// NUMANode is nil when the mismatch concerns the node total.
+	NUMANode *int32
+	Expected int64
+	Actual   int64
+	// Source is either "node status" or "sysfs".
+	Source string
+}
+
+// String returns a human readable description of the mismatch.
+func (mismatch Mismatch) String() string {
+	scope := "total"
+	if mismatch.NUMANode != nil {
+		scope = fmt.Sprintf("NUMA node %d", *mismatch.NUMANode)
+	}
+
+	return fmt.Sprintf("node %s %s %s hugepages from %s: expected %d, found %d",
+		mismatch.NodeName, scope, mismatch.Size, mismatch.Source, mismatch.Expected, mismatch.Actual)
+}
+
+// Total returns the number of pages of the given size summed over all NUMA nodes.
+func (allocation *NodeAllocation) Total(size v2.HugePageSize) int64 {
+	var total int64
+
+	for _, numaPages := range allocation.PerNUMA {
+		total += numaPages[size]
+	}
+
+	return total
+}
+
+// GetNodeAllocation reads the hugepages allocated on the given node from its status and from sysfs. The sysfs
+// tree is read through a short-lived privileged pod running the given image in the given namespace.
+func GetNodeAllocation(
+	apiClient *clients.Settings, nodeName, nsname, image string, timeout time.Duration) (*NodeAllocation, error) {
+	glog.V(100).Infof("Reading hugepages allocation of node %s", nodeName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to read hugepages allocation, 'apiClient' parameter is nil")
+	}
+
+	node, err := nodes.PullNode(apiClient, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	allocation := &NodeAllocation{
+		NodeName: nodeName,
+		Capacity: make(map[v2.HugePageSize]int64),
+		PerNUMA:  make(map[int32]map[v2.HugePageSize]int64),
+	}
+
+	for size, sizeKB := range pageSizes {
+		quantity, ok := node.Object.Status.Capacity[v1.ResourceName(v1.ResourceHugePagesPrefix+string(size)+"i")]
+		if !ok {
+			continue
+		}
+
+		allocation.Capacity[size] = quantity.Value() / (sizeKB * 1024)
+	}
+
+	readerPod, err := pod.NewBuilder(apiClient, fmt.Sprintf("%s-%s", readerPodPrefix, nodeName), nsname, image).
+		DefineOnNode(nodeName).
+		WithPrivilegedFlag().
+		CreateAndWaitUntilRunning(timeout)
+
+	defer func() {
+		if _, err := readerPod.DeleteAndWait(timeout); err != nil {
+			glog.V(100).Infof("Failed to remove hugepages reader pod on node %s: %s", nodeName, err.Error())
+		}
+	}()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create hugepages reader pod on node %s: %w", nodeName, err)
+	}
+
+	output, err := readerPod.ExecCommand([]string{"/bin/sh", "-c", sysfsReadCommand})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read hugepages sysfs on node %s: %w", nodeName, err)
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(output.String()))
+
+	for scanner.Scan() {
+		matches := sysfsLineRegex.FindStringSubmatch(scanner.Text())
+		if len(matches) != 4 {
+			continue
+		}
+
+		numaNode, _ := strconv.ParseInt(matches[1], 10, 32)
+		sizeKB, _ := strconv.ParseInt(matches[2], 10, 64)
+		count, _ := strconv.ParseInt(matches[3], 10, 64)
+
+		size, ok := sizeFromKB(sizeKB)
+		if !ok {
+			continue
+		}
+
+		if _, ok := allocation.PerNUMA[int32(numaNode)]; !ok {
+			allocation.PerNUMA[int32(numaNode)] = make(map[v2.HugePageSize]int64)
+		}
+
+		allocation.PerNUMA[int32(numaNode)][size] = count
+	}
+
+	return allocation, nil
+}
+
+// VerifyPerformanceProfile compares the hugepages requested by the given PerformanceProfile with the ones allocated
+// on every node matching its node selector and returns the mismatches found.
+func VerifyPerformanceProfile(
+	apiClient *clients.Settings, profileName, nsname, image string, timeout time.Duration) ([]Mismatch, error) {
+	glog.V(100).Infof("Verifying hugepages of PerformanceProfile %s", profileName)
+
+	profile, err := nto.Pull(apiClient, profileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.Object.Spec.HugePages == nil || len(profile.Object.Spec.HugePages.Pages) == 0 {
+		return nil, fmt.Errorf("PerformanceProfile %s does not define any hugepages", profileName)
+	}
+
+	profileNodes := nodes.NewBuilder(apiClient, profile.Object.Spec.NodeSelector)
+
+	if err := profileNodes.Discover(); err != nil {
+		return nil, err
+	}
+
+	if len(profileNodes.Objects) == 0 {
+		return nil, fmt.Errorf("no nodes match the node selector of PerformanceProfile %s", profileName)
+	}
+
+	var mismatches []Mismatch
+
+	for _, node := range profileNodes.Objects {
+		allocation, err := GetNodeAllocation(apiClient, node.Object.Name, nsname, image, timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		mismatches = append(mismatches, Compare(allocation, profile.Object.Spec.HugePages.Pages)...)
+	}
+
+	return mismatches, nil
+}
+
+// Compare returns the differences between the node allocation and the expected PerformanceProfile pages.
+func Compare(allocation *NodeAllocation, expectedPages []v2.HugePage) []Mismatch {
+	expectedTotal := make(map[v2.HugePageSize]int64)
+
+	var mismatches []Mismatch
+
+	for _, page := range expectedPages {
+		expectedTotal[page.Size] += int64(page.Count)
+
+		if page.Node == nil {
+			continue
+		}
+
+		actual := allocation.PerNUMA[*page.Node][page.Size]
+		if actual != int64(page.Count) {
+			mismatches = append(mismatches, Mismatch{
+				NodeName: allocation.NodeName,
+				Size:     page.Size,
+				NUMANode: page.Node,
+				Expected: int64(page.Count),
+				Actual:   actual,
+				Source:   "sysfs",
+			})
+		}
+	}
+
+	for size, expected := range expectedTotal {
+		if actual := allocation.Capacity[size]; actual != expected {
+			mismatches = append(mismatches, Mismatch{
+				NodeName: allocation.NodeName, Size: size, Expected: expected, Actual: actual, Source: "node status"})
+		}
+
+		if actual := allocation.Total(size); actual != expected {
+			mismatches = append(mismatches, Mismatch{
+				NodeName: allocation.NodeName, Size: size, Expected: expected, Actual: actual, Source: "sysfs"})
+		}
+	}
+
+	return mismatches
+}
+
+// sizeFromKB converts a sysfs page size in kB into a PerformanceProfile page size.
+func sizeFromKB(sizeKB int64) (v2.HugePageSize, bool) {
+	for size, kiloBytes := range pageSizes {
+		if kiloBytes == sizeKB {
+			return size, true
+		}
+	}
+
+	return "", false
+}
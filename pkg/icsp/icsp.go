@@ -54,19 +54,19 @@ func NewICSPBuilder(apiClient *clients.Settings, name, source string, mirrors []
 	if name == "" {
 		glog.V(100).Infof("The name of the ImageContentSourcePolicy is empty")
 
-		icspBuilder.errorMsg = "ImageContentSourcePolicy 'name' cannot be empty"
+		icspBuilder.errorMsg = msg.AppendErrorMsg(icspBuilder.errorMsg, "ImageContentSourcePolicy 'name' cannot be empty")
 	}
 
 	if source == "" {
 		glog.V(100).Infof("The Source of the ImageContentSourcePolicy is empty")
 
-		icspBuilder.errorMsg = "ImageContentSourcePolicy 'source' cannot be empty"
+		icspBuilder.errorMsg = msg.AppendErrorMsg(icspBuilder.errorMsg, "ImageContentSourcePolicy 'source' cannot be empty")
 	}
 
 	if len(mirrors) == 0 {
 		glog.V(100).Infof("The mirrors of the ImageContentSourcePolicy are empty")
 
-		icspBuilder.errorMsg = "ImageContentSourcePolicy 'mirrors' cannot be empty"
+		icspBuilder.errorMsg = msg.AppendErrorMsg(icspBuilder.errorMsg, "ImageContentSourcePolicy 'mirrors' cannot be empty")
 	}
 
 	return icspBuilder
@@ -102,7 +102,7 @@ func Pull(apiClient *clients.Settings, name string) (*ICSPBuilder, error) {
 	}
 
 	if name == "" {
-		builder.errorMsg = "ImageContentSourcePolicy 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "ImageContentSourcePolicy 'name' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -127,6 +127,9 @@ func (builder *ICSPBuilder) Create() (*ICSPBuilder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.ImageContentSourcePolicies().Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -151,6 +154,8 @@ func (builder *ICSPBuilder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -170,8 +175,13 @@ func (builder *ICSPBuilder) Update() (*ICSPBuilder, error) {
 	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
 	builder.Object, err = builder.apiClient.ImageContentSourcePolicies().Update(
 		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // WithRepositoryDigestMirror adds new RipositoryDigestMirror.
@@ -179,13 +189,13 @@ func (builder *ICSPBuilder) WithRepositoryDigestMirror(source string, mirrors []
 	if source == "" {
 		glog.V(100).Infof("The source is empty")
 
-		builder.errorMsg = "'source' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'source' cannot be empty")
 	}
 
 	if len(mirrors) == 0 {
 		glog.V(100).Infof("Mirrors is empty")
 
-		builder.errorMsg = "'mirrors' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'mirrors' cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -208,15 +218,19 @@ func (builder *ICSPBuilder) WithOptions(options ...AdditionalOptions) *ICSPBuild
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -237,13 +251,14 @@ func (builder *ICSPBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -39,7 +39,7 @@ func NewModLoaderContainerBuilder(modName string) *ModuleLoaderContainerBuilder
 	if modName == "" {
 		glog.V(100).Infof("The modName of the NewModLoaderContainerBuilder is empty")
 
-		builder.errorMsg = "'modName' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'modName' cannot be empty")
 	}
 
 	return builder
@@ -90,7 +90,7 @@ func (builder *ModuleLoaderContainerBuilder) WithKernelMapping(
 	if mapping == nil {
 		glog.V(100).Infof("The mapping is undefined")
 
-		builder.errorMsg = "'mapping' can not be empty nil"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'mapping' can not be empty nil")
 
 		return builder
 	}
@@ -110,7 +110,7 @@ func (builder *ModuleLoaderContainerBuilder) WithImagePullPolicy(policy string)
 		"Creating new ModuleLoaderContainerBuilder structure with following policy %v", policy)
 
 	if policy == "" {
-		builder.errorMsg = "'policy' can not be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'policy' can not be empty")
 
 		return builder
 	}
@@ -129,7 +129,7 @@ func (builder *ModuleLoaderContainerBuilder) WithVersion(version string) *Module
 	glog.V(100).Infof("Setting ModuleLoaderContainer version %v", version)
 
 	if version == "" {
-		builder.errorMsg = "'version' can not be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'version' can not be empty")
 
 		return builder
 	}
@@ -150,15 +150,19 @@ func (builder *ModuleLoaderContainerBuilder) WithOptions(
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -192,7 +196,7 @@ func (builder *ModuleLoaderContainerBuilder) validate() (bool, error) {
 	if builder.definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -227,7 +231,7 @@ func NewDevicePluginContainerBuilder(image string) *DevicePluginContainerBuilder
 	if image == "" {
 		glog.V(100).Infof("The image of NewDevicePluginContainerBuilder is empty")
 
-		builder.errorMsg = "invalid parameter 'image' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "invalid parameter 'image' cannot be empty")
 	}
 
 	return &builder
@@ -245,13 +249,13 @@ func (builder *DevicePluginContainerBuilder) WithEnv(name, value string) *Device
 	if name == "" {
 		glog.V(100).Infof("The name of WithEnv is empty")
 
-		builder.errorMsg = "'name' can not be empty for DevicePlugin Env"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'name' can not be empty for DevicePlugin Env")
 	}
 
 	if value == "" {
 		glog.V(100).Infof("The value of WithEnv is empty")
 
-		builder.errorMsg = "'value' can not be empty for DevicePlugin Env"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'value' can not be empty for DevicePlugin Env")
 	}
 
 	if builder.errorMsg != "" {
@@ -276,13 +280,13 @@ func (builder *DevicePluginContainerBuilder) WithVolumeMount(mountPath, name str
 	if name == "" {
 		glog.V(100).Infof("The name of WithVolumeMount is empty")
 
-		builder.errorMsg = "'name' can not be empty for DevicePlugin mountPath"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'name' can not be empty for DevicePlugin mountPath")
 	}
 
 	if mountPath == "" {
 		glog.V(100).Infof("The mountPath of WithVolumeMount is empty")
 
-		builder.errorMsg = "'mountPath' can not be empty for DevicePlugin mountPath"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'mountPath' can not be empty for DevicePlugin mountPath")
 	}
 
 	if builder.errorMsg != "" {
@@ -319,7 +323,7 @@ func (builder *DevicePluginContainerBuilder) validate() (bool, error) {
 	if builder.definition == nil {
 		glog.V(100).Infof("The %s is undefined", strings.ToLower(resourceCRD))
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -35,7 +35,7 @@ func NewRegExKernelMappingBuilder(regex string) *KernelMappingBuilder {
 	if regex == "" {
 		glog.V(100).Infof("The regex of NewRegExKernelMappingBuilder is empty")
 
-		builder.errorMsg = "'regex' parameter can not be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'regex' parameter can not be empty")
 	}
 
 	return &builder
@@ -55,7 +55,7 @@ func NewLiteralKernelMappingBuilder(literal string) *KernelMappingBuilder {
 	if literal == "" {
 		glog.V(100).Infof("The literal of NewLiteralKernelMappingBuilder is empty")
 
-		builder.errorMsg = "'literal' parameter can not be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'literal' parameter can not be empty")
 	}
 
 	return &builder
@@ -85,7 +85,7 @@ func (builder *KernelMappingBuilder) WithContainerImage(image string) *KernelMap
 	if image == "" {
 		glog.V(100).Infof("The image of WithContainerImage is empty")
 
-		builder.errorMsg = "'image' parameter can not be empty for KernelMapping"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'image' parameter can not be empty for KernelMapping")
 	}
 
 	if builder.errorMsg != "" {
@@ -109,13 +109,15 @@ func (builder *KernelMappingBuilder) WithBuildArg(argName, argValue string) *Ker
 	if argName == "" {
 		glog.V(100).Infof("The argName of WithBuildArg is empty")
 
-		builder.errorMsg = "'argName' parameter can not be empty for KernelMapping BuildArg"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "'argName' parameter can not be empty for KernelMapping BuildArg")
 	}
 
 	if argValue == "" {
 		glog.V(100).Infof("The argValue of WithBuildArg is empty")
 
-		builder.errorMsg = "'argValue' parameter can not be empty for KernelMapping BuildArg"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "'argValue' parameter can not be empty for KernelMapping BuildArg")
 	}
 
 	if builder.errorMsg != "" {
@@ -142,7 +144,8 @@ func (builder *KernelMappingBuilder) WithBuildSecret(secret string) *KernelMappi
 	if secret == "" {
 		glog.V(100).Infof("The secret of WithBuildSecret is empty")
 
-		builder.errorMsg = "'secret' parameter can not be empty for KernelMapping Secret"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "'secret' parameter can not be empty for KernelMapping Secret")
 	}
 
 	if builder.errorMsg != "" {
@@ -185,7 +188,8 @@ func (builder *KernelMappingBuilder) WithBuildDockerCfgFile(name string) *Kernel
 	if name == "" {
 		glog.V(100).Infof("The name of WithBuildDockerCfgFile is empty")
 
-		builder.errorMsg = "'name' parameter can not be empty for KernelMapping Docker file"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "'name' parameter can not be empty for KernelMapping Docker file")
 	}
 
 	if builder.errorMsg != "" {
@@ -211,19 +215,22 @@ func (builder *KernelMappingBuilder) WithSign(certSecret, keySecret string, file
 	if certSecret == "" {
 		glog.V(100).Infof("The certSecret of WithSign is empty")
 
-		builder.errorMsg = "'certSecret' parameter can not be empty for KernelMapping Sign"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "'certSecret' parameter can not be empty for KernelMapping Sign")
 	}
 
 	if keySecret == "" {
 		glog.V(100).Infof("The keySecret of WithSign is empty")
 
-		builder.errorMsg = "'keySecret' parameter can not be empty for KernelMapping Sign"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "'keySecret' parameter can not be empty for KernelMapping Sign")
 	}
 
 	if len(fileToSign) < 1 {
 		glog.V(100).Infof("The fileToSign of WithSign is empty")
 
-		builder.errorMsg = "'fileToSign' parameter can not be empty for KernelMapping Sign"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "'fileToSign' parameter can not be empty for KernelMapping Sign")
 	}
 
 	if builder.errorMsg != "" {
@@ -266,7 +273,8 @@ func (builder *KernelMappingBuilder) WithInTreeModuleToRemove(existingModule str
 	if existingModule == "" {
 		glog.V(100).Infof("The 'existingModule' is empty")
 
-		builder.errorMsg = "'existingModule' parameter can not be empty for KernelMapping inTreeModuleToRemove"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "'existingModule' parameter can not be empty for KernelMapping inTreeModuleToRemove")
 
 		return builder
 	}
@@ -286,15 +294,19 @@ func (builder *KernelMappingBuilder) WithOptions(options ...KernelMappingAdditio
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -321,7 +333,7 @@ func (builder *KernelMappingBuilder) validate() (bool, error) {
 	if builder.definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
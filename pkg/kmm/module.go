@@ -49,13 +49,13 @@ func NewModuleBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the Module is empty")
 
-		builder.errorMsg = "Module 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Module 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the module is empty")
 
-		builder.errorMsg = "Module 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Module 'namespace' cannot be empty")
 	}
 
 	return &builder
@@ -74,7 +74,7 @@ func (builder *ModuleBuilder) WithNodeSelector(nodeSelector map[string]string) *
 	if len(nodeSelector) == 0 {
 		glog.V(100).Infof("Can not redefine Module with empty nodeSelector map")
 
-		builder.errorMsg = "Module 'nodeSelector' cannot be empty map"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Module 'nodeSelector' cannot be empty map")
 	}
 
 	if builder.errorMsg != "" {
@@ -119,7 +119,7 @@ func (builder *ModuleBuilder) WithImageRepoSecret(imageRepoSecret string) *Modul
 	}
 
 	if imageRepoSecret == "" {
-		builder.errorMsg = "can not redefine module with empty imageRepoSecret"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not redefine module with empty imageRepoSecret")
 	}
 
 	if builder.errorMsg != "" {
@@ -138,11 +138,11 @@ func (builder *ModuleBuilder) WithDevicePluginVolume(name string, configMapName
 	}
 
 	if name == "" {
-		builder.errorMsg = "cannot redefine with empty volume 'name'"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot redefine with empty volume 'name'")
 	}
 
 	if configMapName == "" {
-		builder.errorMsg = "cannot redefine with empty 'configMapName'"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot redefine with empty 'configMapName'")
 	}
 
 	if builder.errorMsg != "" {
@@ -174,7 +174,7 @@ func (builder *ModuleBuilder) WithModuleLoaderContainer(
 	}
 
 	if container == nil {
-		builder.errorMsg = "invalid 'container' argument can not be nil"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "invalid 'container' argument can not be nil")
 	}
 
 	if builder.errorMsg != "" {
@@ -194,7 +194,7 @@ func (builder *ModuleBuilder) WithDevicePluginContainer(
 	}
 
 	if container == nil {
-		builder.errorMsg = "invalid 'container' argument can not be nil"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "invalid 'container' argument can not be nil")
 	}
 
 	if builder.errorMsg != "" {
@@ -220,15 +220,19 @@ func (builder *ModuleBuilder) WithOptions(options ...ModuleAdditionalOptions) *M
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -252,13 +256,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*ModuleBuilder, err
 	if name == "" {
 		glog.V(100).Infof("The name of the module is empty")
 
-		builder.errorMsg = "module 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "module 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the module is empty")
 
-		builder.errorMsg = "module 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "module 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -370,7 +374,7 @@ func (builder *ModuleBuilder) withServiceAccount(srvAccountName string, accountT
 	}
 
 	if srvAccountName == "" {
-		builder.errorMsg = "can not redefine module with empty ServiceAccount"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not redefine module with empty ServiceAccount")
 	}
 
 	if builder.errorMsg != "" {
@@ -387,7 +391,8 @@ func (builder *ModuleBuilder) withServiceAccount(srvAccountName string, accountT
 
 		builder.Definition.Spec.DevicePlugin.ServiceAccountName = srvAccountName
 	default:
-		builder.errorMsg = "invalid account type parameter. Supported parameters are: 'module', 'device'"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "invalid account type parameter. Supported parameters are: 'module', 'device'")
 	}
 
 	return builder
@@ -407,13 +412,14 @@ func (builder *ModuleBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
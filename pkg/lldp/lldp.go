@@ -0,0 +1,210 @@
+package lldp
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/daemonset"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	collectorName          = "lldp-collector"
+	collectorContainerName = "lldpd"
+	collectorAppLabel      = "app"
+)
+
+// Neighbor describes a single LLDP neighbor seen on a node interface.
+type Neighbor struct {
+	// LocalInterface is the node interface the neighbor was discovered on.
+	LocalInterface string
+	// ChassisID is the chassis identifier advertised by the neighbor, usually its MAC address.
+	ChassisID string
+	// SystemName is the system name advertised by the neighbor, usually the switch hostname.
+	SystemName string
+	// PortID is the identifier of the neighbor port the node interface is cabled to.
+	PortID string
+	// PortDescription is the description of the neighbor port.
+	PortDescription string
+}
+
+// Collector provides struct for the short-lived LLDP collection daemonset and its settings.
+type Collector struct {
+	apiClient *clients.Settings
+	daemonSet *daemonset.Builder
+	nsname    string
+}
+
+// NewCollector creates a new instance of Collector deploying the given lldpd image in the given namespace.
+// The namespace must allow privileged pods running on the host network.
+func NewCollector(
+	apiClient *clients.Settings, nsname, image string, nodeSelector map[string]string) (*Collector, error) {
+	glog.V(100).Infof("Initializing new LLDP collector in namespace %s with image %s", nsname, image)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to initialize LLDP collector, 'apiClient' parameter is nil")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the LLDP collector is empty")
+
+		return nil, fmt.Errorf("failed to initialize LLDP collector, 'nsname' parameter is empty")
+	}
+
+	if image == "" {
+		glog.V(100).Infof("The image of the LLDP collector is empty")
+
+		return nil, fmt.Errorf("failed to initialize LLDP collector, 'image' parameter is empty")
+	}
+
+	privileged := true
+	container := coreV1.Container{
+		Name:            collectorContainerName,
+		Image:           image,
+		Command:         []string{"lldpd", "-d"},
+		SecurityContext: &coreV1.SecurityContext{Privileged: &privileged},
+	}
+
+	daemonSetBuilder := daemonset.NewBuilder(
+		apiClient, collectorName, nsname, map[string]string{collectorAppLabel: collectorName}, container).
+		WithOptions(func(builder *daemonset.Builder) (*daemonset.Builder, error) {
+			builder.Definition.Spec.Template.Spec.HostNetwork = true
+
+			return builder, nil
+		})
+
+	if len(nodeSelector) != 0 {
+		daemonSetBuilder.WithNodeSelector(nodeSelector)
+	}
+
+	return &Collector{
+		apiClient: apiClient,
+		daemonSet: daemonSetBuilder,
+		nsname:    nsname,
+	}, nil
+}
+
+// Collect deploys the LLDP collection daemonset, waits collectionTime for neighbors to advertise themselves,
+// gathers the neighbor tables and removes the daemonset. The result is keyed by node name.
+func (collector *Collector) Collect(collectionTime, timeout time.Duration) (map[string][]Neighbor, error) {
+	if collector == nil {
+		return nil, fmt.Errorf("error: received nil LLDP collector")
+	}
+
+	glog.V(100).Infof("Collecting LLDP neighbors in namespace %s", collector.nsname)
+
+	if _, err := collector.daemonSet.Create(); err != nil {
+		return nil, fmt.Errorf("failed to create LLDP collector daemonset: %w", err)
+	}
+
+	defer func() {
+		if err := collector.daemonSet.DeleteAndWait(timeout); err != nil {
+			glog.V(100).Infof("Failed to remove LLDP collector daemonset: %s", err.Error())
+		}
+	}()
+
+	if !collector.daemonSet.IsReady(timeout) {
+		return nil, fmt.Errorf("LLDP collector daemonset is not ready after %s", timeout)
+	}
+
+	// LLDP neighbors advertise themselves periodically, usually every 30 seconds.
+	time.Sleep(collectionTime)
+
+	collectorPods, err := pod.List(collector.apiClient, collector.nsname, metaV1.ListOptions{
+		LabelSelector: labels.Set{collectorAppLabel: collectorName}.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	neighbors := make(map[string][]Neighbor)
+
+	for _, collectorPod := range collectorPods {
+		output, err := collectorPod.ExecCommand(
+			[]string{"lldpcli", "show", "neighbors", "-f", "json0"}, collectorContainerName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read LLDP neighbors from pod %s: %w", collectorPod.Object.Name, err)
+		}
+
+		nodeNeighbors, err := parseNeighbors(output.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse LLDP neighbors of node %s: %w",
+				collectorPod.Object.Spec.NodeName, err)
+		}
+
+		neighbors[collectorPod.Object.Spec.NodeName] = nodeNeighbors
+	}
+
+	return neighbors, nil
+}
+
+// lldpValue is a single value entry of the lldpcli json0 output.
+type lldpValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// lldpInterface is a single interface entry of the lldpcli json0 output.
+type lldpInterface struct {
+	Name    string `json:"name"`
+	Chassis []struct {
+		ID   []lldpValue `json:"id"`
+		Name []lldpValue `json:"name"`
+	} `json:"chassis"`
+	Port []struct {
+		ID    []lldpValue `json:"id"`
+		Descr []lldpValue `json:"descr"`
+	} `json:"port"`
+}
+
+// parseNeighbors converts the lldpcli json0 output into a list of neighbors.
+func parseNeighbors(output []byte) ([]Neighbor, error) {
+	var lldpOutput struct {
+		LLDP []struct {
+			Interface []lldpInterface `json:"interface"`
+		} `json:"lldp"`
+	}
+
+	if err := json.Unmarshal(output, &lldpOutput); err != nil {
+		return nil, err
+	}
+
+	var neighbors []Neighbor
+
+	for _, lldp := range lldpOutput.LLDP {
+		for _, lldpIface := range lldp.Interface {
+			neighbor := Neighbor{LocalInterface: lldpIface.Name}
+
+			if len(lldpIface.Chassis) > 0 {
+				neighbor.ChassisID = firstValue(lldpIface.Chassis[0].ID)
+				neighbor.SystemName = firstValue(lldpIface.Chassis[0].Name)
+			}
+
+			if len(lldpIface.Port) > 0 {
+				neighbor.PortID = firstValue(lldpIface.Port[0].ID)
+				neighbor.PortDescription = firstValue(lldpIface.Port[0].Descr)
+			}
+
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+
+	return neighbors, nil
+}
+
+// firstValue returns the first value of an lldpcli json0 entry list or an empty string.
+func firstValue(values []lldpValue) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0].Value
+}
@@ -0,0 +1,14 @@
+package mco
+
+import (
+	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+)
+
+// Condition types of a MachineConfigPool, as passed to WaitToBeInCondition and IsInCondition.
+const (
+	ConditionUpdated        = mcov1.MachineConfigPoolUpdated
+	ConditionUpdating       = mcov1.MachineConfigPoolUpdating
+	ConditionNodeDegraded   = mcov1.MachineConfigPoolNodeDegraded
+	ConditionRenderDegraded = mcov1.MachineConfigPoolRenderDegraded
+	ConditionDegraded       = mcov1.MachineConfigPoolDegraded
+)
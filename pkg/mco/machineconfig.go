@@ -45,7 +45,7 @@ func NewMCBuilder(apiClient *clients.Settings, name string) *MCBuilder {
 	if name == "" {
 		glog.V(100).Infof("The name of the MachineConfig is empty")
 
-		builder.errorMsg = "MachineConfig 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "MachineConfig 'name' cannot be empty")
 	}
 
 	return &builder
@@ -67,7 +67,7 @@ func PullMachineConfig(apiClient *clients.Settings, name string) (*MCBuilder, er
 	if name == "" {
 		glog.V(100).Infof("The name of the machineconfig is empty")
 
-		builder.errorMsg = "machineconfig 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "machineconfig 'name' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -91,6 +91,9 @@ func (builder *MCBuilder) Create() (*MCBuilder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.MachineConfigs().Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -115,6 +118,8 @@ func (builder *MCBuilder) Delete() error {
 		return fmt.Errorf("cannot delete MachineConfig: %w", err)
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -131,8 +136,13 @@ func (builder *MCBuilder) Update() (*MCBuilder, error) {
 	var err error
 	builder.Object, err = builder.apiClient.MachineConfigs().Update(
 		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // Exists checks whether the given machineconfig exists.
@@ -161,7 +171,7 @@ func (builder *MCBuilder) WithLabel(key, value string) *MCBuilder {
 	if key == "" {
 		glog.V(100).Infof("The key can't be empty")
 
-		builder.errorMsg = "'key' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'key' cannot be empty")
 
 		return builder
 	}
@@ -185,15 +195,19 @@ func (builder *MCBuilder) WithOptions(options ...MCAdditionalOptions) *MCBuilder
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -209,7 +223,7 @@ func (builder *MCBuilder) WithKernelArguments(kernelArgs []string) *MCBuilder {
 	if len(kernelArgs) == 0 {
 		glog.V(100).Infof("The kernelArgs can't be empty")
 
-		builder.errorMsg = "'kernelArgs' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'kernelArgs' cannot be empty")
 
 		return builder
 	}
@@ -230,7 +244,7 @@ func (builder *MCBuilder) WithExtensions(extensions []string) *MCBuilder {
 	if len(extensions) == 0 {
 		glog.V(100).Infof("The extensions can't be empty")
 
-		builder.errorMsg = "'extensions' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'extensions' cannot be empty")
 
 		return builder
 	}
@@ -264,7 +278,7 @@ func (builder *MCBuilder) WithKernelType(kernelType string) *MCBuilder {
 	if kernelType == "" {
 		glog.V(100).Infof("The kernelType can't be empty")
 
-		builder.errorMsg = "'kernelType' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'kernelType' cannot be empty")
 
 		return builder
 	}
@@ -288,13 +302,14 @@ func (builder *MCBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -55,7 +56,7 @@ func NewMCPBuilder(apiClient *clients.Settings, mcpName string) *MCPBuilder {
 	if mcpName == "" {
 		glog.V(100).Infof("The name of the MachineConfigPool is empty")
 
-		builder.errorMsg = "MachineConfigPool 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "MachineConfigPool 'name' cannot be empty")
 	}
 
 	return builder
@@ -77,7 +78,7 @@ func Pull(apiClient *clients.Settings, name string) (*MCPBuilder, error) {
 	if name == "" {
 		glog.V(100).Infof("The name of the machineconfigpool is empty")
 
-		builder.errorMsg = "machineconfigpool 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "machineconfigpool 'name' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -102,6 +103,9 @@ func (builder *MCPBuilder) Create() (*MCPBuilder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.MachineConfigPools().Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -127,6 +131,8 @@ func (builder *MCPBuilder) Delete() error {
 		return fmt.Errorf("cannot delete MachineConfigPool: %w", err)
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	return err
 }
 
@@ -156,7 +162,7 @@ func (builder *MCPBuilder) WithMcSelector(mcSelector map[string]string) *MCPBuil
 		"machineConfigSelector label: %v", mcSelector)
 
 	if len(mcSelector) == 0 {
-		builder.errorMsg = "'machineConfigSelector MatchLabels' field cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'machineConfigSelector MatchLabels' field cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -174,6 +180,7 @@ func (builder *MCPBuilder) WaitToBeInCondition(
 	conditionType mcov1.MachineConfigPoolConditionType,
 	conditionStatus corev1.ConditionStatus,
 	timeout time.Duration,
+	options ...await.WaitOption,
 ) error {
 	if valid, err := builder.validate(); !valid {
 		return err
@@ -182,7 +189,11 @@ func (builder *MCPBuilder) WaitToBeInCondition(
 	glog.V(100).Infof("WaitToBeInCondition waits up to specified time duration %v until "+
 		"MachineConfigPool condition %v is met", timeout, conditionType)
 
-	return wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
+	options = append([]await.WaitOption{await.WithInterval(fiveScds)}, options...)
+
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
 		mcp, err := builder.apiClient.MachineConfigPools().Get(context.Background(),
 			builder.Object.Name, metav1.GetOptions{})
 
@@ -190,18 +201,28 @@ func (builder *MCPBuilder) WaitToBeInCondition(
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("condition %s missing", conditionType)
+
 		for _, condition := range mcp.Status.Conditions {
-			if condition.Type == conditionType && condition.Status == conditionStatus {
+			if condition.Type != conditionType {
+				continue
+			}
+
+			lastObserved = fmt.Sprintf("condition %s %s", conditionType, condition.Status)
+
+			if condition.Status == conditionStatus {
 				return true, nil
 			}
 		}
 
 		return false, nil
-	})
+	}, options...)
+
+	return builder.withTimeoutDetails(err, fmt.Sprintf("condition %s %s", conditionType, conditionStatus), lastObserved)
 }
 
 // WaitForUpdate waits for a MachineConfigPool to be updating and then updated.
-func (builder *MCPBuilder) WaitForUpdate(timeout time.Duration) error {
+func (builder *MCPBuilder) WaitForUpdate(timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -216,9 +237,13 @@ func (builder *MCPBuilder) WaitForUpdate(timeout time.Duration) error {
 		return err
 	}
 
+	options = append([]await.WaitOption{await.WithInterval(fiveScds)}, options...)
+
 	for _, condition := range mcpUpdating.Status.Conditions {
 		if condition.Type == "Updating" && condition.Status == isTrue {
-			err := wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
+			lastObserved := "condition Updated missing"
+
+			err := await.Poll(timeout, func() (bool, error) {
 				mcpUpdated, err := builder.apiClient.MachineConfigPools().Get(context.Background(),
 					builder.Object.Name, metav1.GetOptions{})
 
@@ -227,16 +252,20 @@ func (builder *MCPBuilder) WaitForUpdate(timeout time.Duration) error {
 				}
 
 				for _, condition := range mcpUpdated.Status.Conditions {
-					if condition.Type == "Updated" && condition.Status == isTrue {
-						return true, nil
+					if condition.Type == "Updated" {
+						lastObserved = fmt.Sprintf("condition Updated %s", condition.Status)
+
+						if condition.Status == isTrue {
+							return true, nil
+						}
 					}
 				}
 
 				return false, nil
-			})
+			}, options...)
 
 			if err != nil {
-				return err
+				return builder.withTimeoutDetails(err, "condition Updated True", lastObserved)
 			}
 		}
 	}
@@ -245,7 +274,8 @@ func (builder *MCPBuilder) WaitForUpdate(timeout time.Duration) error {
 }
 
 // WaitToBeStableFor waits on MachineConfigPool to stable for a time duration or until timeout.
-func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeout time.Duration) error {
+func (builder *MCPBuilder) WaitToBeStableFor(
+	stableDuration time.Duration, timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -254,10 +284,12 @@ func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeo
 		"MachineConfigPool to be stable for %v", timeout, stableDuration)
 
 	isMcpStable := true
+	lastObserved := ""
+	options = append([]await.WaitOption{await.WithInterval(fiveScds)}, options...)
 
 	// Wait 5 secs in each iteration before condition function () returns true or errors
 	// or times out after stableDuration
-	err := wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 
 		isMcpStable = true
 
@@ -278,6 +310,9 @@ func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeo
 					builder.Object.Status.ReadyMachineCount, builder.Object.Status.DegradedMachineCount)
 
 				isMcpStable = false
+				lastObserved = fmt.Sprintf("%d machines, %d updated, %d ready, %d degraded",
+					builder.Object.Status.MachineCount, builder.Object.Status.UpdatedMachineCount,
+					builder.Object.Status.ReadyMachineCount, builder.Object.Status.DegradedMachineCount)
 
 				return true, nil
 			}
@@ -289,18 +324,18 @@ func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeo
 			glog.V(100).Infof("MachineConfigPool was stable during during stableDuration: %v",
 				stableDuration)
 
-			// this will exit the outer wait.PollImmediate block since the mcp was stable during stableDuration
+			// this will exit the outer await.Poll block since the mcp was stable during stableDuration
 			return true, nil
 		}
 
 		glog.V(100).Infof("MachineConfigPool was not stable during stableDuration: %v, retrying ...",
 			stableDuration)
 
-		// keep iterating in the outer wait.PollImmediate waiting for cluster to be stable
+		// keep iterating in the outer await.Poll waiting for cluster to be stable
 		return false, nil
-	})
+	}, options...)
 
-	// After the timout in outer wait.PollImmediate.
+	// After the timout in outer await.Poll.
 	if err == nil {
 		glog.V(100).Infof("Cluster was stable during stableDuration: %v", stableDuration)
 	} else {
@@ -308,7 +343,7 @@ func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeo
 		glog.V(100).Infof("Cluster was Un-stable during stableDuration: %v", stableDuration)
 	}
 
-	return err
+	return builder.withTimeoutDetails(err, fmt.Sprintf("stable for %s", stableDuration), lastObserved)
 }
 
 // WithOptions creates mcp with generic mutation options.
@@ -321,15 +356,19 @@ func (builder *MCPBuilder) WithOptions(options ...MCPAdditionalOptions) *MCPBuil
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -357,6 +396,16 @@ func (builder *MCPBuilder) IsInCondition(mcpConditionType mcov1.MachineConfigPoo
 	return false
 }
 
+// withTimeoutDetails converts a wait timeout into an await.WaitTimeoutError referencing the MachineConfigPool.
+func (builder *MCPBuilder) withTimeoutDetails(err error, wanted, lastObserved string) error {
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          mcov1.GroupVersion.WithKind(machineConfigPool),
+		Name:         builder.Definition.Name,
+		Wanted:       wanted,
+		LastObserved: lastObserved,
+	})
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *MCPBuilder) validate() (bool, error) {
@@ -371,13 +420,14 @@ func (builder *MCPBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
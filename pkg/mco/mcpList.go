@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 
 	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
@@ -84,15 +85,18 @@ func (builder *MCPListBuilder) Discover() error {
 
 // WaitToBeStableFor waits on all MachineConfigPools in a MachineConfigConfigPoolList to be
 // stable for a time duration up to the timeout.
-func (builder *MCPListBuilder) WaitToBeStableFor(stableDuration time.Duration, timeout time.Duration) error {
+func (builder *MCPListBuilder) WaitToBeStableFor(
+	stableDuration time.Duration, timeout time.Duration, options ...await.WaitOption) error {
 	glog.V(100).Infof("WaitForMcpListToBeStableFor waits up to duration of %v for "+
 		"MachineConfigPoolList to be stable for %v", timeout, stableDuration)
 
 	isMcpListStable := true
+	lastObserved := ""
+	options = append([]await.WaitOption{await.WithInterval(fiveScds)}, options...)
 
 	// Wait 5 secs in each iteration before condition function () returns true or errors or times out
 	// after stableDuration
-	err := wait.PollImmediate(fiveScds, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 
 		isMcpListStable = true
 
@@ -112,6 +116,9 @@ func (builder *MCPListBuilder) WaitToBeStableFor(stableDuration time.Duration, t
 					mcp.Status.MachineCount != mcp.Status.UpdatedMachineCount ||
 					mcp.Status.DegradedMachineCount != 0 {
 					isMcpListStable = false
+					lastObserved = fmt.Sprintf("MachineConfigPool %s with %d machines, %d updated, %d ready, %d degraded",
+						mcp.Name, mcp.Status.MachineCount, mcp.Status.UpdatedMachineCount,
+						mcp.Status.ReadyMachineCount, mcp.Status.DegradedMachineCount)
 
 					glog.V(100).Infof("MachineConfigPool: %v degraded and has a mismatch in "+
 						"machineCount: %v "+"vs machineCountUpdated: "+"%v vs readyMachineCount: %v and "+
@@ -132,17 +139,17 @@ func (builder *MCPListBuilder) WaitToBeStableFor(stableDuration time.Duration, t
 			glog.V(100).Infof("MachineConfigPools were stable during during stableDuration: %v",
 				stableDuration)
 
-			// exit the outer wait.PollImmediate block since the mcps were stable during stableDuration.
+			// exit the outer await.Poll block since the mcps were stable during stableDuration.
 			return true, nil
 		}
 
 		glog.V(100).Infof("MachineConfigPools were not stable during stableDuration: %v, retrying ...",
 			stableDuration)
 
-		// keep iterating in the outer wait.PollImmediate waiting for cluster to be stable.
+		// keep iterating in the outer await.Poll waiting for cluster to be stable.
 		return false, nil
 
-	})
+	}, options...)
 
 	if err == nil {
 		glog.V(100).Infof("Cluster was stable during stableDuration: %v", stableDuration)
@@ -151,7 +158,12 @@ func (builder *MCPListBuilder) WaitToBeStableFor(stableDuration time.Duration, t
 		glog.V(100).Infof("Cluster was Un-stable during stableDuration: %v", stableDuration)
 	}
 
-	return err
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          mcov1.GroupVersion.WithKind(machineConfigPool),
+		Name:         "list",
+		Wanted:       fmt.Sprintf("all stable for %s", stableDuration),
+		LastObserved: lastObserved,
+	})
 }
 
 // GetByLabel returns all MachineConfigPools with the specified label.
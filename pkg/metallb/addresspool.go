@@ -49,19 +49,19 @@ func NewIPAddressPoolBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the IPAddressPool is empty")
 
-		builder.errorMsg = "IPAddressPool 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "IPAddressPool 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the IPAddressPool is empty")
 
-		builder.errorMsg = "IPAddressPool 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "IPAddressPool 'nsname' cannot be empty")
 	}
 
 	if len(addrPool) < 1 {
 		glog.V(100).Infof("The addrPool of the IPAddressPool is empty list")
 
-		builder.errorMsg = "IPAddressPool 'addrPool' cannot be empty list"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "IPAddressPool 'addrPool' cannot be empty list")
 	}
 
 	return &builder
@@ -127,13 +127,13 @@ func PullAddressPool(apiClient *clients.Settings, name, nsname string) (*IPAddre
 	if name == "" {
 		glog.V(100).Infof("The name of the addresspool is empty")
 
-		builder.errorMsg = "addresspool 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "addresspool 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the addresspool is empty")
 
-		builder.errorMsg = "addresspool 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "addresspool 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -271,7 +271,7 @@ func (builder *IPAddressPoolBuilder) WithOptions(options ...IPAddressPoolAdditio
 	if builder.Definition == nil {
 		glog.V(100).Infof("The IPAddressPool is undefined")
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString("IPAddressPool")
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString("IPAddressPool"))
 	}
 
 	if builder.errorMsg != "" {
@@ -280,15 +280,19 @@ func (builder *IPAddressPoolBuilder) WithOptions(options ...IPAddressPoolAdditio
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -316,13 +320,14 @@ func (builder *IPAddressPoolBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
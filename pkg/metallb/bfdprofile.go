@@ -47,13 +47,13 @@ func NewBFDBuilder(apiClient *clients.Settings, name, nsname string) *BFDBuilder
 	if name == "" {
 		glog.V(100).Infof("The name of the BFDProfile is empty")
 
-		builder.errorMsg = "BFDProfile 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BFDProfile 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the BFDProfile is empty")
 
-		builder.errorMsg = "BFDProfile 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BFDProfile 'nsname' cannot be empty")
 	}
 
 	return &builder
@@ -119,13 +119,13 @@ func PullBFDProfile(apiClient *clients.Settings, name, nsname string) (*BFDBuild
 	if name == "" {
 		glog.V(100).Infof("The name of the bfdprofile is empty")
 
-		builder.errorMsg = "bfdprofile 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "bfdprofile 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the bfdprofile is empty")
 
-		builder.errorMsg = "bfdprofile 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "bfdprofile 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -287,15 +287,19 @@ func (builder *BFDBuilder) WithOptions(options ...BFDAdditionalOptions) *BFDBuil
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -317,7 +321,7 @@ func (builder *BFDBuilder) withBoolFlagFor(flagName string, flagValue bool) *BFD
 	case "passiveMode":
 		builder.Definition.Spec.PassiveMode = &flagValue
 	default:
-		builder.errorMsg = "invalid bool flag name parameter"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "invalid bool flag name parameter")
 	}
 
 	return builder
@@ -340,7 +344,7 @@ func (builder *BFDBuilder) withInterval(intervalName string, interval uint32) *B
 	case "ecoInterval":
 		builder.Definition.Spec.EchoInterval = &interval
 	default:
-		builder.errorMsg = "invalid interval parameters"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "invalid interval parameters")
 	}
 
 	return builder
@@ -367,13 +371,14 @@ func (builder *BFDBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -45,13 +45,13 @@ func NewBGPAdvertisementBuilder(apiClient *clients.Settings, name, nsname string
 	if name == "" {
 		glog.V(100).Infof("The name of the BGPAdvertisement is empty")
 
-		builder.errorMsg = "BGPAdvertisement 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BGPAdvertisement 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the BGPAdvertisement is empty")
 
-		builder.errorMsg = "BGPAdvertisement 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BGPAdvertisement 'nsname' cannot be empty")
 	}
 
 	return &builder
@@ -117,13 +117,13 @@ func PullBGPAdvertisement(apiClient *clients.Settings, name, nsname string) (*BG
 	if name == "" {
 		glog.V(100).Infof("The name of the bgpadvertisement is empty")
 
-		builder.errorMsg = "bgpadvertisement 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "bgpadvertisement 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the bgpadvertisement is empty")
 
-		builder.errorMsg = "bgpadvertisement 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "bgpadvertisement 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -242,8 +242,8 @@ func (builder *BGPAdvertisementBuilder) WithAggregationLength4(aggregationLength
 		builder.Definition.Name, builder.Definition.Namespace, aggregationLength)
 
 	if aggregationLength < 0 || aggregationLength > 32 {
-		builder.errorMsg = fmt.Sprintf("AggregationLength %d is invalid, the value shoud be in range 0...32",
-			aggregationLength)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf(
+			"AggregationLength %d is invalid, the value shoud be in range 0...32", aggregationLength))
 	}
 
 	if builder.errorMsg != "" {
@@ -266,8 +266,8 @@ func (builder *BGPAdvertisementBuilder) WithAggregationLength6(aggregationLength
 		builder.Definition.Name, builder.Definition.Namespace, aggregationLength)
 
 	if !(aggregationLength < 0 || aggregationLength > 128) {
-		builder.errorMsg = fmt.Sprintf("AggregationLength %d is invalid, the value shoud be in range 0...128",
-			aggregationLength)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf(
+			"AggregationLength %d is invalid, the value shoud be in range 0...128", aggregationLength))
 	}
 
 	if builder.errorMsg != "" {
@@ -305,7 +305,8 @@ func (builder *BGPAdvertisementBuilder) WithCommunities(communities []string) *B
 		builder.Definition.Name, builder.Definition.Namespace, communities)
 
 	if len(communities) < 1 {
-		builder.errorMsg = "error: community setting is empty list, the list should contain at least one element"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "error: community setting is empty list, the list should contain at least one element")
 	}
 
 	if builder.errorMsg != "" {
@@ -328,7 +329,8 @@ func (builder *BGPAdvertisementBuilder) WithIPAddressPools(ipAddressPools []stri
 		builder.Definition.Name, builder.Definition.Namespace, ipAddressPools)
 
 	if len(ipAddressPools) < 1 {
-		builder.errorMsg = "error: IPAddressPools setting is empty list, the list should contain at least one element"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "error: IPAddressPools setting is empty list, the list should contain at least one element")
 	}
 
 	if builder.errorMsg != "" {
@@ -352,8 +354,8 @@ func (builder *BGPAdvertisementBuilder) WithIPAddressPoolsSelectors(
 		builder.Definition.Name, builder.Definition.Namespace, poolSelector)
 
 	if len(poolSelector) < 1 {
-		builder.errorMsg = "error: IPAddressPoolSelectors setting is empty list, " +
-			"the list should contain at least one element"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "error: IPAddressPoolSelectors setting is empty list, "+
+			"the list should contain at least one element")
 	}
 
 	if builder.errorMsg != "" {
@@ -377,7 +379,8 @@ func (builder *BGPAdvertisementBuilder) WithNodeSelector(
 		builder.Definition.Name, builder.Definition.Namespace, nodeSelectors)
 
 	if len(nodeSelectors) < 1 {
-		builder.errorMsg = "error: nodeSelectors setting is empty list, the list should contain at least one element"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "error: nodeSelectors setting is empty list, the list should contain at least one element")
 	}
 
 	if builder.errorMsg != "" {
@@ -400,7 +403,8 @@ func (builder *BGPAdvertisementBuilder) WithPeers(peers []string) *BGPAdvertisem
 		builder.Definition.Name, builder.Definition.Namespace, peers)
 
 	if len(peers) < 1 {
-		builder.errorMsg = "error: peers setting is empty list, the list should contain at least one element"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "error: peers setting is empty list, the list should contain at least one element")
 	}
 
 	if builder.errorMsg != "" {
@@ -423,15 +427,19 @@ func (builder *BGPAdvertisementBuilder) WithOptions(
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -459,13 +467,14 @@ func (builder *BGPAdvertisementBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
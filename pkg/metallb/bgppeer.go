@@ -52,19 +52,19 @@ func NewBPGPeerBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the BGPPeer is empty")
 
-		builder.errorMsg = "BGPPeer 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BGPPeer 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the BGPPeer is empty")
 
-		builder.errorMsg = "BGPPeer 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BGPPeer 'nsname' cannot be empty")
 	}
 
 	if net.ParseIP(peerIP) == nil {
 		glog.V(100).Infof("The peerIP of the BGPPeer contains invalid ip address %s", peerIP)
 
-		builder.errorMsg = "BGPPeer 'peerIP' of the BGPPeer contains invalid ip address"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BGPPeer 'peerIP' of the BGPPeer contains invalid ip address")
 	}
 
 	return &builder
@@ -130,13 +130,13 @@ func PullBGPPeer(apiClient *clients.Settings, name, nsname string) (*BGPPeerBuil
 	if name == "" {
 		glog.V(100).Infof("The name of the bgppeer is empty")
 
-		builder.errorMsg = "bgppeer 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "bgppeer 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the bgppeer is empty")
 
-		builder.errorMsg = "bgppeer 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "bgppeer 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -247,7 +247,8 @@ func (builder *BGPPeerBuilder) WithRouterID(routerID string) *BGPPeerBuilder {
 		glog.V(100).Infof("The routerID of the BGPPeer contains invalid ip address %s, "+
 			"routerID should be present in ip address format", routerID)
 
-		builder.errorMsg = fmt.Sprintf("the routerID of the BGPPeer contains invalid ip address %s", routerID)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("the routerID of the BGPPeer contains invalid ip address %s", routerID))
 	}
 
 	if builder.errorMsg != "" {
@@ -272,7 +273,7 @@ func (builder *BGPPeerBuilder) WithBFDProfile(bfdProfile string) *BGPPeerBuilder
 	if bfdProfile == "" {
 		glog.V(100).Infof("The bfdProfile of the BGPPeer can not be empty string")
 
-		builder.errorMsg = "The bfdProfile is empty sting"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "The bfdProfile is empty sting")
 	}
 
 	if builder.errorMsg != "" {
@@ -298,7 +299,8 @@ func (builder *BGPPeerBuilder) WithSRCAddress(srcAddress string) *BGPPeerBuilder
 		glog.V(100).Infof("The srcAddress of the BGPPeer contains invalid ip address %s, "+
 			"srcAddress should be present in ip address format", srcAddress)
 
-		builder.errorMsg = fmt.Sprintf("the srcAddress of the BGPPeer contains invalid ip address %s", srcAddress)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("the srcAddress of the BGPPeer contains invalid ip address %s", srcAddress))
 	}
 
 	if builder.errorMsg != "" {
@@ -368,7 +370,7 @@ func (builder *BGPPeerBuilder) WithNodeSelector(nodeSelector map[string]string)
 	if len(nodeSelector) == 0 {
 		glog.V(100).Infof("Can not redefine BGPPeer with empty nodeSelector map")
 
-		builder.errorMsg = "BGPPeer 'nodeSelector' cannot be empty map"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "BGPPeer 'nodeSelector' cannot be empty map")
 	}
 
 	if builder.errorMsg != "" {
@@ -394,7 +396,7 @@ func (builder *BGPPeerBuilder) WithPassword(password string) *BGPPeerBuilder {
 	if password == "" {
 		glog.V(100).Infof("Can not redefine BGPPeer with empty password")
 
-		builder.errorMsg = "password can not be empty sting"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "password can not be empty sting")
 	}
 
 	if builder.errorMsg != "" {
@@ -431,15 +433,19 @@ func (builder *BGPPeerBuilder) WithOptions(options ...BGPPeerAdditionalOptions)
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -467,13 +473,14 @@ func (builder *BGPPeerBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -48,13 +48,13 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, label map[stri
 	if name == "" {
 		glog.V(100).Infof("The name of the metallb is empty")
 
-		builder.errorMsg = "metallb 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "metallb 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the metallb is empty")
 
-		builder.errorMsg = "metallb 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "metallb 'nsname' cannot be empty")
 	}
 
 	return &builder
@@ -78,13 +78,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if name == "" {
 		glog.V(100).Infof("The name of the metallb is empty")
 
-		builder.errorMsg = "metallb 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "metallb 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the metallb is empty")
 
-		builder.errorMsg = "metallb 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "metallb 'nsname' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -242,7 +242,7 @@ func (builder *Builder) RemoveLabel(key string) *Builder {
 
 	if key == "" {
 		glog.V(100).Infof("Failed to remove empty label's key from metalLbIo %s", builder.Definition.Name)
-		builder.errorMsg = "error to remove empty key from metalLbIo"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "error to remove empty key from metalLbIo")
 	}
 
 	if builder.errorMsg != "" {
@@ -265,7 +265,8 @@ func (builder *Builder) WithSpeakerNodeSelector(label map[string]string) *Builde
 	)
 
 	if len(label) < 1 {
-		builder.errorMsg = "can not accept empty label and redefine metallb NodeSelector"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "can not accept empty label and redefine metallb NodeSelector")
 	}
 
 	if builder.errorMsg != "" {
@@ -287,15 +288,19 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -323,13 +328,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
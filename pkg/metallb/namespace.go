@@ -0,0 +1,15 @@
+package metallb
+
+import "github.com/openshift-kni/eco-goinfra/pkg/clients"
+
+// NamespaceComponent identifies the MetalLB operator in the namespace overrides of clients.Settings.
+const NamespaceComponent = "metallb"
+
+// DefaultNamespace is the namespace the MetalLB operator is installed in by default.
+var DefaultNamespace = "metallb-system"
+
+// Namespace returns the namespace of the MetalLB operator, which is the namespace overridden on apiClient for
+// NamespaceComponent if any, or DefaultNamespace otherwise.
+func Namespace(apiClient *clients.Settings) string {
+	return apiClient.Namespace(NamespaceComponent, DefaultNamespace)
+}
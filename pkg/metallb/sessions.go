@@ -0,0 +1,223 @@
+package metallb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// BGPStateEstablished is the FRR state of a BGP session that is up and exchanging routes.
+	BGPStateEstablished = "Established"
+	// BFDStatusUp is the FRR status of a BFD session that is up.
+	BFDStatusUp = "up"
+)
+
+// BGPSession contains the state of a BGP session as reported by the FRR container of a speaker pod.
+type BGPSession struct {
+	Peer     string `json:"-"`
+	RemoteAS int64  `json:"remoteAs"`
+	LocalAS  int64  `json:"localAs"`
+	State    string `json:"bgpState"`
+	// UpTimeMsec is the time the session has been established for. It is 0 if the session is down.
+	UpTimeMsec int64 `json:"bgpTimerUpMsec"`
+}
+
+// BFDPeer contains the state of a BFD session as reported by the FRR container of a speaker pod.
+type BFDPeer struct {
+	Peer                  string `json:"peer"`
+	Multihop              bool   `json:"multihop"`
+	Status                string `json:"status"`
+	Uptime                int64  `json:"uptime"`
+	ReceiveInterval       int64  `json:"receive-interval"`
+	TransmitInterval      int64  `json:"transmit-interval"`
+	RemoteReceiveInterval int64  `json:"remote-receive-interval"`
+	DetectMultiplier      int64  `json:"detect-multiplier"`
+}
+
+// GetBGPSessions returns the BGP sessions of every speaker pod in the MetalLB operator namespace, keyed by node name.
+func GetBGPSessions(apiClient *clients.Settings) (map[string][]BGPSession, error) {
+	glog.V(100).Infof("Getting BGP sessions of metallb speakers in namespace %s", Namespace(apiClient))
+
+	speakerPods, err := ListSpeakerPods(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make(map[string][]BGPSession)
+
+	for _, speakerPod := range speakerPods {
+		nodeSessions, err := getSpeakerBGPSessions(speakerPod)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions[speakerPod.Object.Spec.NodeName] = nodeSessions
+	}
+
+	return sessions, nil
+}
+
+// GetBFDPeers returns the BFD sessions of every speaker pod in the MetalLB operator namespace, keyed by node name.
+func GetBFDPeers(apiClient *clients.Settings) (map[string][]BFDPeer, error) {
+	glog.V(100).Infof("Getting BFD peers of metallb speakers in namespace %s", Namespace(apiClient))
+
+	speakerPods, err := ListSpeakerPods(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make(map[string][]BFDPeer)
+
+	for _, speakerPod := range speakerPods {
+		output, err := speakerPod.ExecCommand([]string{"vtysh", "-c", "show bfd peers json"}, speakerFRRContainer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query bfd peers on speaker pod %s: %w", speakerPod.Object.Name, err)
+		}
+
+		var nodePeers []BFDPeer
+
+		if err := json.Unmarshal([]byte(strings.TrimSpace(output.String())), &nodePeers); err != nil {
+			return nil, fmt.Errorf("failed to parse bfd peers of speaker pod %s: %w", speakerPod.Object.Name, err)
+		}
+
+		peers[speakerPod.Object.Spec.NodeName] = nodePeers
+	}
+
+	return peers, nil
+}
+
+// WaitForSessionEstablished waits for timeout duration or until the BGP session with the given peer is established
+// on every speaker pod in the MetalLB operator namespace. If bfd is true, the BFD session with the peer must be up as
+// well.
+func WaitForSessionEstablished(
+	apiClient *clients.Settings,
+	peer string,
+	bfd bool,
+	timeout time.Duration,
+	options ...await.WaitOption) error {
+	nsname := Namespace(apiClient)
+
+	glog.V(100).Infof("Waiting for sessions with peer %s to be established on speakers in namespace %s",
+		peer, nsname)
+
+	if peer == "" {
+		glog.V(100).Infof("The peer address is empty")
+
+		return fmt.Errorf("failed to wait for session, 'peer' cannot be empty")
+	}
+
+	var notEstablished []string
+
+	err := await.Poll(timeout, func() (bool, error) {
+		sessions, err := GetBGPSessions(apiClient)
+		if err != nil {
+			glog.V(100).Infof("Failed to get BGP sessions: %s", err.Error())
+
+			return false, nil
+		}
+
+		notEstablished = nil
+
+		for nodeName, nodeSessions := range sessions {
+			if getBGPSessionState(nodeSessions, peer) != BGPStateEstablished {
+				notEstablished = append(notEstablished, nodeName)
+			}
+		}
+
+		if bfd && len(notEstablished) == 0 {
+			peers, err := GetBFDPeers(apiClient)
+			if err != nil {
+				glog.V(100).Infof("Failed to get BFD peers: %s", err.Error())
+
+				return false, nil
+			}
+
+			for nodeName, nodePeers := range peers {
+				if getBFDPeerStatus(nodePeers, peer) != BFDStatusUp {
+					notEstablished = append(notEstablished, nodeName)
+				}
+			}
+		}
+
+		return len(notEstablished) == 0, nil
+	}, options...)
+
+	if err == nil {
+		return nil
+	}
+
+	sort.Strings(notEstablished)
+
+	err = await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          v1.SchemeGroupVersion.WithKind("Pod"),
+		Namespace:    nsname,
+		Wanted:       fmt.Sprintf("sessions with peer %s established on every speaker", peer),
+		LastObserved: fmt.Sprintf("sessions not established on nodes %v", notEstablished),
+	})
+
+	var timeoutErr *await.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		return err
+	}
+
+	return fmt.Errorf("session with peer %s is not established on nodes %v: %w", peer, notEstablished, err)
+}
+
+// getSpeakerBGPSessions returns the BGP sessions reported by the FRR container of the given speaker pod.
+func getSpeakerBGPSessions(speakerPod *pod.Builder) ([]BGPSession, error) {
+	output, err := speakerPod.ExecCommand([]string{"vtysh", "-c", "show bgp neighbors json"}, speakerFRRContainer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bgp neighbors on speaker pod %s: %w", speakerPod.Object.Name, err)
+	}
+
+	var neighbors map[string]BGPSession
+
+	if err := json.Unmarshal([]byte(strings.TrimSpace(output.String())), &neighbors); err != nil {
+		return nil, fmt.Errorf("failed to parse bgp neighbors of speaker pod %s: %w", speakerPod.Object.Name, err)
+	}
+
+	var sessions []BGPSession
+
+	for peer, session := range neighbors {
+		session.Peer = peer
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Peer < sessions[j].Peer
+	})
+
+	return sessions, nil
+}
+
+// getBGPSessionState returns the state of the session with the given peer, or an empty string if there is none.
+func getBGPSessionState(sessions []BGPSession, peer string) string {
+	for _, session := range sessions {
+		if session.Peer == peer {
+			return session.State
+		}
+	}
+
+	return ""
+}
+
+// getBFDPeerStatus returns the status of the BFD session with the given peer, or an empty string if there is none.
+func getBFDPeerStatus(peers []BFDPeer, peer string) string {
+	for _, bfdPeer := range peers {
+		if bfdPeer.Peer == peer {
+			return bfdPeer.Status
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,234 @@
+package metallb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"github.com/openshift-kni/eco-goinfra/pkg/service"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+const (
+	// speakerLabelSelector is the label selector matching the metallb speaker pods.
+	speakerLabelSelector = "component=speaker"
+	// speakerFRRContainer is the name of the FRR container running inside of the speaker pod.
+	speakerFRRContainer = "frr"
+	// nodeAssignedEventReason is the reason of the event the speaker emits when a node starts announcing a service.
+	nodeAssignedEventReason = "nodeAssigned"
+)
+
+// announcingNodeRegex extracts the node name out of the speaker nodeAssigned event message.
+var announcingNodeRegex = regexp.MustCompile(`announcing from node "([^"]+)"`)
+
+// ListSpeakerPods returns the metallb speaker pods running in the MetalLB operator namespace.
+func ListSpeakerPods(apiClient *clients.Settings) ([]*pod.Builder, error) {
+	nsname := Namespace(apiClient)
+
+	glog.V(100).Infof("Listing metallb speaker pods in namespace %s", nsname)
+
+	speakerPods, err := pod.List(apiClient, nsname, metaV1.ListOptions{LabelSelector: speakerLabelSelector})
+	if err != nil {
+		glog.V(100).Infof("Failed to list metallb speaker pods in namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	if len(speakerPods) == 0 {
+		return nil, fmt.Errorf("no metallb speaker pods found in namespace %s", nsname)
+	}
+
+	return speakerPods, nil
+}
+
+// GetL2AnnouncingNode returns the node currently announcing the given LoadBalancer service in layer2 mode.
+// The node is taken from the most recent nodeAssigned event emitted by the speakers for the service.
+func GetL2AnnouncingNode(apiClient *clients.Settings, serviceName, serviceNsName string) (string, error) {
+	glog.V(100).Infof("Getting layer2 announcing node of service %s in namespace %s", serviceName, serviceNsName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return "", fmt.Errorf("failed to get announcing node, 'apiClient' parameter is nil")
+	}
+
+	if serviceName == "" || serviceNsName == "" {
+		glog.V(100).Infof("The service name or namespace is empty")
+
+		return "", fmt.Errorf("failed to get announcing node, service name and namespace cannot be empty")
+	}
+
+	eventSelector := fields.Set{
+		"involvedObject.kind": "Service",
+		"involvedObject.name": serviceName,
+		"reason":              nodeAssignedEventReason,
+	}.AsSelector().String()
+
+	events, err := apiClient.Events(serviceNsName).List(context.TODO(), metaV1.ListOptions{FieldSelector: eventSelector})
+	if err != nil {
+		glog.V(100).Infof("Failed to list events of service %s due to %s", serviceName, err.Error())
+
+		return "", err
+	}
+
+	if len(events.Items) == 0 {
+		return "", fmt.Errorf("service %s in namespace %s is not announced by any node", serviceName, serviceNsName)
+	}
+
+	sort.Slice(events.Items, func(i, j int) bool {
+		return eventTime(events.Items[i]).Before(eventTime(events.Items[j]))
+	})
+
+	latestEvent := events.Items[len(events.Items)-1]
+
+	matches := announcingNodeRegex.FindStringSubmatch(latestEvent.Message)
+	if len(matches) != 2 {
+		return "", fmt.Errorf("failed to parse announcing node from event message: %s", latestEvent.Message)
+	}
+
+	return matches[1], nil
+}
+
+// WaitForL2AnnouncingNodeChange waits until the given LoadBalancer service is announced by a node other than
+// previousNode and returns the new announcing node. It is meant to be used in failover scenarios.
+func WaitForL2AnnouncingNodeChange(
+	apiClient *clients.Settings,
+	serviceName, serviceNsName, previousNode string,
+	timeout time.Duration,
+	options ...await.WaitOption) (string, error) {
+	glog.V(100).Infof("Waiting for service %s in namespace %s to be announced by a node other than %s",
+		serviceName, serviceNsName, previousNode)
+
+	var announcingNode string
+
+	err := await.Poll(timeout, func() (bool, error) {
+		currentNode, err := GetL2AnnouncingNode(apiClient, serviceName, serviceNsName)
+		if err != nil {
+			glog.V(100).Infof("Failed to get announcing node: %s", err.Error())
+
+			return false, nil
+		}
+
+		announcingNode = currentNode
+
+		return currentNode != previousNode, nil
+	}, options...)
+
+	if err != nil {
+		lastObserved := "no announcing node"
+		if announcingNode != "" {
+			lastObserved = fmt.Sprintf("announced by node %s", announcingNode)
+		}
+
+		err = await.WithTimeoutDetails(err, await.WaitTimeoutError{
+			GVK:          v1.SchemeGroupVersion.WithKind("Service"),
+			Name:         serviceName,
+			Namespace:    serviceNsName,
+			Wanted:       fmt.Sprintf("announcement by a node other than %s", previousNode),
+			LastObserved: lastObserved,
+		})
+
+		var timeoutErr *await.WaitTimeoutError
+		if errors.As(err, &timeoutErr) {
+			return "", err
+		}
+
+		return "", fmt.Errorf("service %s in namespace %s is still announced by node %s: %w",
+			serviceName, serviceNsName, previousNode, err)
+	}
+
+	return announcingNode, nil
+}
+
+// GetBGPAdvertisingNodes returns the names of the nodes whose speaker has the LoadBalancer IP of the given service
+// in its FRR BGP table, i.e. the nodes advertising the service to their BGP peers.
+func GetBGPAdvertisingNodes(apiClient *clients.Settings, serviceName, serviceNsName string) ([]string, error) {
+	glog.V(100).Infof("Getting nodes advertising service %s in namespace %s over BGP",
+		serviceName, serviceNsName)
+
+	serviceBuilder, err := service.Pull(apiClient, serviceName, serviceNsName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(serviceBuilder.Object.Status.LoadBalancer.Ingress) == 0 {
+		return nil, fmt.Errorf("service %s in namespace %s has no LoadBalancer IP assigned",
+			serviceName, serviceNsName)
+	}
+
+	speakerPods, err := ListSpeakerPods(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var advertisingNodes []string
+
+	for _, ingress := range serviceBuilder.Object.Status.LoadBalancer.Ingress {
+		for _, speakerPod := range speakerPods {
+			advertised, err := isPrefixInBGPTable(speakerPod, ingress.IP)
+			if err != nil {
+				return nil, err
+			}
+
+			if advertised {
+				advertisingNodes = append(advertisingNodes, speakerPod.Object.Spec.NodeName)
+			}
+		}
+	}
+
+	return advertisingNodes, nil
+}
+
+// isPrefixInBGPTable checks whether the host prefix of the given IP is present in the speaker FRR BGP table.
+func isPrefixInBGPTable(speakerPod *pod.Builder, ipAddress string) (bool, error) {
+	parsedIP := net.ParseIP(ipAddress)
+	if parsedIP == nil {
+		return false, fmt.Errorf("invalid LoadBalancer IP address %s", ipAddress)
+	}
+
+	addressFamily, prefix := "ipv4", ipAddress+"/32"
+	if parsedIP.To4() == nil {
+		addressFamily, prefix = "ipv6", ipAddress+"/128"
+	}
+
+	output, err := speakerPod.ExecCommand(
+		[]string{"vtysh", "-c", fmt.Sprintf("show bgp %s unicast %s json", addressFamily, prefix)}, speakerFRRContainer)
+	if err != nil {
+		return false, fmt.Errorf("failed to query bgp table on speaker pod %s: %w", speakerPod.Object.Name, err)
+	}
+
+	var bgpRoute map[string]interface{}
+
+	if err := json.Unmarshal([]byte(strings.TrimSpace(output.String())), &bgpRoute); err != nil {
+		return false, fmt.Errorf("failed to parse bgp table of speaker pod %s: %w", speakerPod.Object.Name, err)
+	}
+
+	_, found := bgpRoute["paths"]
+
+	return found, nil
+}
+
+// eventTime returns the most relevant timestamp of the given event.
+func eventTime(event v1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+
+	return event.CreationTimestamp.Time
+}
@@ -0,0 +1,143 @@
+package monitoring
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Sample is a single sample of a metric in the Prometheus text format. For histograms and summaries Value is the
+// sum of all observed values and Count the number of observations, for counters and gauges Count is 0.
+type Sample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+	Count  uint64
+}
+
+// FilterSamples returns the samples of the metric with the given name.
+func FilterSamples(samples []Sample, name string) []Sample {
+	var filtered []Sample
+
+	for _, sample := range samples {
+		if sample.Name == name {
+			filtered = append(filtered, sample)
+		}
+	}
+
+	return filtered
+}
+
+// ParseSamples parses metrics in the Prometheus text format and returns all of their samples, sorted by metric
+// name.
+func ParseSamples(reader io.Reader) ([]Sample, error) {
+	var parser expfmt.TextParser
+
+	families, err := parser.TextToMetricFamilies(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var samples []Sample
+
+	for _, name := range names {
+		for _, metric := range families[name].GetMetric() {
+			samples = append(samples, newSample(name, metric))
+		}
+	}
+
+	return samples, nil
+}
+
+// ScrapePod reads the metrics served on path by the given port of the pod, through the pod proxy of the API server,
+// and returns all of their samples. It is meant for metrics that differ per pod, e.g. the ones of a daemon set, which
+// a service would load balance. The scheme is http or https, an empty scheme lets the API server pick it.
+func ScrapePod(apiClient *clients.Settings, nsname, scheme, podName, port, path string) ([]Sample, error) {
+	glog.V(100).Infof("Scraping metrics from %s on port %s of pod %s in namespace %s", path, port, podName, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to scrape metrics, 'apiClient' parameter is nil")
+	}
+
+	if nsname == "" || podName == "" || port == "" {
+		glog.V(100).Infof("The metrics pod is incomplete")
+
+		return nil, fmt.Errorf("failed to scrape metrics, namespace, pod and port cannot be empty")
+	}
+
+	output, err := apiClient.Pods(nsname).ProxyGet(scheme, podName, port, path, nil).DoRaw(context.TODO())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metrics from pod %s: %w", podName, err)
+	}
+
+	return ParseSamples(bytes.NewReader(output))
+}
+
+// ScrapeService reads the metrics served on path by the given port of the service, through the service proxy of
+// the API server, and returns all of their samples. The scheme is http or https, an empty scheme lets the API server
+// pick it. The port is the name or the number of a port of the service.
+func ScrapeService(apiClient *clients.Settings, nsname, scheme, service, port, path string) ([]Sample, error) {
+	glog.V(100).Infof("Scraping metrics from %s on port %s of service %s in namespace %s",
+		path, port, service, nsname)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to scrape metrics, 'apiClient' parameter is nil")
+	}
+
+	if nsname == "" || service == "" || port == "" {
+		glog.V(100).Infof("The metrics service is incomplete")
+
+		return nil, fmt.Errorf("failed to scrape metrics, namespace, service and port cannot be empty")
+	}
+
+	output, err := apiClient.Services(nsname).ProxyGet(scheme, service, port, path, nil).DoRaw(context.TODO())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metrics from service %s: %w", service, err)
+	}
+
+	return ParseSamples(bytes.NewReader(output))
+}
+
+// newSample converts a parsed metric into a Sample.
+func newSample(name string, metric *dto.Metric) Sample {
+	sample := Sample{Name: name, Labels: make(map[string]string)}
+
+	for _, label := range metric.GetLabel() {
+		sample.Labels[label.GetName()] = label.GetValue()
+	}
+
+	switch {
+	case metric.Histogram != nil:
+		sample.Value = metric.GetHistogram().GetSampleSum()
+		sample.Count = metric.GetHistogram().GetSampleCount()
+	case metric.Summary != nil:
+		sample.Value = metric.GetSummary().GetSampleSum()
+		sample.Count = metric.GetSummary().GetSampleCount()
+	case metric.Counter != nil:
+		sample.Value = metric.GetCounter().GetValue()
+	case metric.Gauge != nil:
+		sample.Value = metric.GetGauge().GetValue()
+	default:
+		sample.Value = metric.GetUntyped().GetValue()
+	}
+
+	return sample
+}
@@ -1,8 +1,34 @@
 package msg
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // UndefinedCrdObjectErrString returns an error message for an undefined CR.
 func UndefinedCrdObjectErrString(crName string) string {
 	return fmt.Sprintf("can not redefine the undefined %s", crName)
 }
+
+// errorMsgSeparator separates the messages aggregated by AppendErrorMsg.
+const errorMsgSeparator = "; "
+
+// AppendErrorMsg returns the builder error message errorMsg extended with newMsg, so that all invalid arguments of
+// a builder are reported instead of only the last one. A message already present in errorMsg is not repeated.
+func AppendErrorMsg(errorMsg, newMsg string) string {
+	if newMsg == "" {
+		return errorMsg
+	}
+
+	if errorMsg == "" {
+		return newMsg
+	}
+
+	for _, existingMsg := range strings.Split(errorMsg, errorMsgSeparator) {
+		if existingMsg == newMsg {
+			return errorMsg
+		}
+	}
+
+	return errorMsg + errorMsgSeparator + newMsg
+}
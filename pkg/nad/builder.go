@@ -49,13 +49,13 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	if builder.Definition.Name == "" {
 		glog.V(100).Infof("The name of the NetworkAttachmentDefinition is empty")
 
-		builder.errorMsg = "NAD name is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "NAD name is empty")
 	}
 
 	if builder.Definition.Namespace == "" {
 		glog.V(100).Infof("The namespace of the NetworkAttachmentDefinition is empty")
 
-		builder.errorMsg = "NAD namespace is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "NAD namespace is empty")
 	}
 
 	return &builder
@@ -78,13 +78,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if name == "" {
 		glog.V(100).Infof("The name of the networkattachmentdefinition is empty")
 
-		builder.errorMsg = "networkattachmentdefinition 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "networkattachmentdefinition 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the networkattachmentdefinition is empty")
 
-		builder.errorMsg = "networkattachmentdefinition 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "networkattachmentdefinition 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -150,6 +150,8 @@ func (builder *Builder) Delete() error {
 		return fmt.Errorf("fail to delete NAD object due to: %w", err)
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Definition)
+
 	builder.Object = nil
 
 	return nil
@@ -171,8 +173,13 @@ func (builder *Builder) Update() (*Builder, error) {
 
 	builder.Object, err = builder.apiClient.NetworkAttachmentDefinitions(builder.Definition.Namespace).Update(
 		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
+
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
 
-	return builder, err
+	return builder, nil
 }
 
 // Exists checks if a NAD is exists in the builder.
@@ -254,13 +261,13 @@ func (builder *Builder) WithMasterPlugin(masterPlugin *MasterPlugin) *Builder {
 	emptyNadConfig := nadV1.NetworkAttachmentDefinitionSpec{}
 
 	if builder.Definition.Spec != emptyNadConfig {
-		builder.errorMsg = "error to redefine predefine NAD"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "error to redefine predefine NAD")
 	}
 
 	masterPluginSting, err := json.Marshal(masterPlugin)
 
 	if err != nil {
-		builder.errorMsg = err.Error()
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 	}
 
 	builder.Definition.Spec.Config = string(masterPluginSting)
@@ -285,7 +292,7 @@ func (builder *Builder) WithPlugins(name string, plugins *[]Plugin) *Builder {
 	pluginsConfigString, err := json.Marshal(pluginsConfig)
 
 	if err != nil {
-		builder.errorMsg = err.Error()
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 	}
 
 	builder.Definition.Spec.Config = string(pluginsConfigString)
@@ -314,13 +321,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
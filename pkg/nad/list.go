@@ -0,0 +1,37 @@
+package nad
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeleteAllOf removes all NADs in the given namespace matching options with a single DeleteCollection call.
+func DeleteAllOf(apiClient *clients.Settings, nsname string, options metaV1.ListOptions) error {
+	glog.V(100).Infof("Deleting all NADs in the nsname %s with the options %v", nsname, options)
+
+	if apiClient == nil {
+		glog.V(100).Infof("NADs 'apiClient' parameter can not be empty")
+
+		return fmt.Errorf("failed to delete NADs, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("NAD 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to delete NADs, 'nsname' parameter is empty")
+	}
+
+	err := apiClient.NetworkAttachmentDefinitions(nsname).DeleteCollection(
+		context.TODO(), metaV1.DeleteOptions{}, options)
+	if err != nil {
+		glog.V(100).Infof("Failed to delete NADs in the nsname %s due to %s", nsname, err.Error())
+
+		return err
+	}
+
+	return nil
+}
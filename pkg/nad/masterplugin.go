@@ -36,7 +36,7 @@ func NewMasterMacVlanPlugin(name string) *MasterMacVlanPlugin {
 	if builder.masterPlugin.Name == "" {
 		glog.V(100).Infof("error MasterMacVlanPlugin can not be empty")
 
-		builder.errorMsg = "MasterMacVlanPlugin name is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "MasterMacVlanPlugin name is empty")
 	}
 
 	return &builder
@@ -49,12 +49,12 @@ func (plugin *MasterMacVlanPlugin) WithMode(mode string) *MasterMacVlanPlugin {
 	if !slices.Contains(allowedMacVlanMode, mode) {
 		glog.V(100).Infof("error to add mode %s, allowed modes are %v", mode, allowedMacVlanMode)
 
-		plugin.errorMsg = "invalid mode parameter"
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, "invalid mode parameter")
 	}
 
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
 	}
 
 	plugin.masterPlugin.Mode = mode
@@ -68,13 +68,13 @@ func (plugin *MasterMacVlanPlugin) WithMasterInterface(master string) *MasterMac
 
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
 	}
 
 	if master == "" {
 		glog.V(100).Infof("error to add master interface, the name of interface can not be empty")
 
-		plugin.errorMsg = "invalid master parameter"
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, "invalid master parameter")
 	}
 
 	plugin.masterPlugin.Master = master
@@ -88,13 +88,13 @@ func (plugin *MasterMacVlanPlugin) WithIPAM(ipam *IPAM) *MasterMacVlanPlugin {
 
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
 	}
 
 	if ipam == nil {
 		glog.V(100).Infof("error to add empty ipam to MasterMacVlanPlugin")
 
-		plugin.errorMsg = invalidIpamParameterMsg
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, invalidIpamParameterMsg)
 	}
 
 	plugin.masterPlugin.Ipam = ipam
@@ -108,7 +108,7 @@ func (plugin *MasterMacVlanPlugin) WithLinkInContainer() *MasterMacVlanPlugin {
 
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
 	}
 
 	plugin.masterPlugin.LinkInContainer = true
@@ -148,7 +148,7 @@ func NewMasterBridgePlugin(name, bridgeName string) *MasterBridgePlugin {
 	if builder.masterPlugin.Name == "" {
 		glog.V(100).Infof("error MasterBridgePlugin can not be empty")
 
-		builder.errorMsg = "MasterBridgePlugin name is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "MasterBridgePlugin name is empty")
 	}
 
 	return &builder
@@ -169,13 +169,13 @@ func (plugin *MasterBridgePlugin) WithIPAM(ipam *IPAM) *MasterBridgePlugin {
 
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterBridgePlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBridgePlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterBridgePlugin"))
 	}
 
 	if ipam == nil {
 		glog.V(100).Infof("error adding empty ipam to MasterBridgePlugin")
 
-		plugin.errorMsg = invalidIpamParameterMsg
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, invalidIpamParameterMsg)
 	}
 
 	plugin.masterPlugin.Ipam = ipam
@@ -206,13 +206,13 @@ func NewMasterVlanPlugin(name string, vlanID uint16) *MasterVlanPlugin {
 	if vlanID > 4094 {
 		glog.V(100).Infof("error vlan id can not be greater than 4094")
 
-		builder.errorMsg = "MasterVlanPlugin vlanID is greater than 4094"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "MasterVlanPlugin vlanID is greater than 4094")
 	}
 
 	if builder.masterPlugin.Name == "" {
 		glog.V(100).Infof("error MasterVlanPlugin name can not be empty")
 
-		builder.errorMsg = "MasterVlanPlugin name is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "MasterVlanPlugin name is empty")
 	}
 
 	return &builder
@@ -224,13 +224,13 @@ func (plugin *MasterVlanPlugin) WithIPAM(ipam *IPAM) *MasterVlanPlugin {
 
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterVlanPlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
 	}
 
 	if ipam == nil {
 		glog.V(100).Infof("error adding empty ipam to MasterVlanPlugin")
 
-		plugin.errorMsg = invalidIpamParameterMsg
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, invalidIpamParameterMsg)
 	}
 
 	if plugin.errorMsg != "" {
@@ -248,13 +248,13 @@ func (plugin *MasterVlanPlugin) WithMasterInterface(masterInterfaceName string)
 
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterVlanPlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
 	}
 
 	if masterInterfaceName == "" {
 		glog.V(100).Infof("error to add masterInterfaceName interface, the name of interface can not be empty")
 
-		plugin.errorMsg = "invalid masterInterfaceName parameter"
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, "invalid masterInterfaceName parameter")
 	}
 
 	if plugin.errorMsg != "" {
@@ -270,7 +270,7 @@ func (plugin *MasterVlanPlugin) WithMasterInterface(masterInterfaceName string)
 func (plugin *MasterVlanPlugin) WithLinkInContainer() *MasterVlanPlugin {
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterVlanPlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
 	}
 
 	if plugin.errorMsg != "" {
@@ -313,7 +313,7 @@ func NewMasterIPVlanPlugin(name string) *MasterIPVlanPlugin {
 	if builder.masterPlugin.Name == "" {
 		glog.V(100).Infof("error MasterIPVlanPlugin can not be empty")
 
-		builder.errorMsg = "MasterIPVlanPlugin name is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "MasterIPVlanPlugin name is empty")
 	}
 
 	return &builder
@@ -325,13 +325,13 @@ func (plugin *MasterIPVlanPlugin) WithIPAM(ipam *IPAM) *MasterIPVlanPlugin {
 
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
 	}
 
 	if ipam == nil {
 		glog.V(100).Infof("error adding empty ipam to MasterIPVlanPlugin")
 
-		plugin.errorMsg = invalidIpamParameterMsg
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, invalidIpamParameterMsg)
 	}
 
 	if plugin.errorMsg != "" {
@@ -349,13 +349,13 @@ func (plugin *MasterIPVlanPlugin) WithMasterInterface(masterInterfaceName string
 
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
 	}
 
 	if masterInterfaceName == "" {
 		glog.V(100).Infof("error to add master interface, the name of interface can not be empty")
 
-		plugin.errorMsg = "invalid masterInterfaceName parameter"
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, "invalid masterInterfaceName parameter")
 	}
 
 	if plugin.errorMsg != "" {
@@ -373,7 +373,7 @@ func (plugin *MasterIPVlanPlugin) WithLinkInContainer() *MasterIPVlanPlugin {
 
 	if plugin.masterPlugin == nil {
 		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")
+		plugin.errorMsg = msg.AppendErrorMsg(plugin.errorMsg, msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
 	}
 
 	if plugin.errorMsg != "" {
@@ -0,0 +1,59 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FindOlderThan returns the namespaces matching options that were created more than age ago, e.g. test namespaces
+// left behind by aborted test runs. The optional options should select the test namespaces only, e.g. through a label
+// selector.
+func FindOlderThan(apiClient *clients.Settings, age time.Duration, options ...metaV1.ListOptions) ([]*Builder, error) {
+	glog.V(100).Infof("Finding namespaces older than %s with the options %v", age, options)
+
+	if apiClient == nil {
+		glog.V(100).Infof("namespaces 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list namespaces, 'apiClient' parameter is empty")
+	}
+
+	if len(options) > 1 {
+		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	passedOptions := metaV1.ListOptions{}
+	if len(options) == 1 {
+		passedOptions = options[0]
+	}
+
+	namespaceList, err := apiClient.Namespaces().List(context.TODO(), passedOptions)
+	if err != nil {
+		glog.V(100).Infof("Failed to list namespaces due to %s", err.Error())
+
+		return nil, err
+	}
+
+	var staleNamespaces []*Builder
+
+	for _, runningNamespace := range namespaceList.Items {
+		if time.Since(runningNamespace.CreationTimestamp.Time) <= age {
+			continue
+		}
+
+		copiedNamespace := runningNamespace
+		staleNamespaces = append(staleNamespaces, &Builder{
+			apiClient:  apiClient,
+			Object:     &copiedNamespace,
+			Definition: &copiedNamespace,
+		})
+	}
+
+	return staleNamespaces, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "k8s.io/api/core/v1"
@@ -13,7 +14,6 @@ import (
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/pointer"
 	"k8s.io/utils/strings/slices"
 )
@@ -50,7 +50,7 @@ func NewBuilder(apiClient *clients.Settings, name string) *Builder {
 	if name == "" {
 		glog.V(100).Infof("The name of the namespace is empty")
 
-		builder.errorMsg = "namespace 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "namespace 'name' cannot be empty")
 	}
 
 	return &builder
@@ -67,7 +67,7 @@ func (builder *Builder) WithLabel(key string, value string) *Builder {
 	if key == "" {
 		glog.V(100).Infof("The key can't be empty")
 
-		builder.errorMsg = "'key' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'key' cannot be empty")
 
 		return builder
 	}
@@ -100,15 +100,19 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -127,6 +131,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Namespaces().Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -143,8 +150,13 @@ func (builder *Builder) Update() (*Builder, error) {
 	var err error
 	builder.Object, err = builder.apiClient.Namespaces().Update(
 		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // Delete removes a namespace.
@@ -165,13 +177,15 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
 }
 
 // DeleteAndWait deletes a namespace and waits until it's removed from the cluster.
-func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+func (builder *Builder) DeleteAndWait(timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -182,7 +196,7 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		return err
 	}
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 		_, err := builder.apiClient.Namespaces().Get(context.Background(), builder.Definition.Name, metaV1.GetOptions{})
 		if k8serrors.IsNotFound(err) {
 
@@ -190,6 +204,13 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		}
 
 		return false, nil
+	}, options...)
+
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          v1.SchemeGroupVersion.WithKind("Namespace"),
+		Name:         builder.Definition.Name,
+		Wanted:       "deletion",
+		LastObserved: "object still present",
 	})
 }
 
@@ -208,6 +229,19 @@ func (builder *Builder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// Age returns the time elapsed since the namespace was created.
+func (builder *Builder) Age() (time.Duration, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	if !builder.Exists() || builder.Object == nil {
+		return 0, fmt.Errorf("namespace %s does not exist", builder.Definition.Name)
+	}
+
+	return time.Since(builder.Object.CreationTimestamp.Time), nil
+}
+
 // Pull loads existing namespace in to Builder struct.
 func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing namespace: %s from cluster", nsname)
@@ -222,7 +256,7 @@ func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -236,6 +270,13 @@ func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
 
 // CleanObjects removes given objects from the namespace.
 func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...schema.GroupVersionResource) error {
+	return builder.CleanObjectsWithOptions(cleanTimeout, objects)
+}
+
+// CleanObjectsWithOptions behaves like CleanObjects and applies the given wait options to the wait for the removal
+// of every resource. The objects are checked every 3 seconds unless an interval is passed.
+func (builder *Builder) CleanObjectsWithOptions(
+	cleanTimeout time.Duration, objects []schema.GroupVersionResource, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -268,7 +309,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 			return err
 		}
 
-		err = wait.PollImmediate(3*time.Second, cleanTimeout, func() (bool, error) {
+		err = await.Poll(cleanTimeout, func() (bool, error) {
 			objList, err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).List(
 				context.Background(), metaV1.ListOptions{})
 
@@ -283,7 +324,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 			}
 
 			return true, err
-		})
+		}, append([]await.WaitOption{await.WithInterval(3 * time.Second)}, options...)...)
 
 		if err != nil {
 			glog.V(100).Infof("Failed to remove resources: %s in namespace: %s",
@@ -338,13 +379,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
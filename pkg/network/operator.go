@@ -6,12 +6,12 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	operatorV1 "github.com/openshift/api/operator/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -137,7 +137,7 @@ func (builder *OperatorBuilder) SetLocalGWMode(state bool, timeout time.Duration
 // WaitUntilInCondition waits for a specific time duration until the network.operator will have a
 // specified condition type with the expected status.
 func (builder *OperatorBuilder) WaitUntilInCondition(
-	condition string, timeout time.Duration, status operatorV1.ConditionStatus) error {
+	condition string, timeout time.Duration, status operatorV1.ConditionStatus, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -145,22 +145,38 @@ func (builder *OperatorBuilder) WaitUntilInCondition(
 	glog.V(100).Infof("Wait until network.operator object %s is in condition %v",
 		builder.Definition.Name, condition)
 
-	err := wait.PollImmediate(3*time.Second, timeout, func() (bool, error) {
+	options = append([]await.WaitOption{await.WithInterval(3 * time.Second)}, options...)
+
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
 		if !builder.Exists() {
 			return false, fmt.Errorf("network.operator object doesn't exist")
 		}
 
+		lastObserved = fmt.Sprintf("condition %s missing", condition)
+
 		for _, c := range builder.Object.Status.OperatorStatus.Conditions {
-			if c.Type == condition && c.Status == status {
+			if c.Type != condition {
+				continue
+			}
+
+			lastObserved = fmt.Sprintf("condition %s %s", condition, c.Status)
+
+			if c.Status == status {
 				return true, nil
 			}
 		}
 
 		return false, nil
+	}, options...)
 
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          operatorV1.GroupVersion.WithKind("Network"),
+		Name:         builder.Definition.Name,
+		Wanted:       fmt.Sprintf("condition %s %s", condition, status),
+		LastObserved: lastObserved,
 	})
-
-	return err
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -177,13 +193,14 @@ func (builder *OperatorBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
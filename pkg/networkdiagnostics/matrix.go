@@ -0,0 +1,271 @@
+package networkdiagnostics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/golang/glog"
+	netAttDefV1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	// DefaultNetwork is the network name used in the matrix for the cluster default network.
+	DefaultNetwork = "default"
+
+	probePodPrefix = "connectivity-probe"
+	probePingCount = "3"
+)
+
+// Network describes a network covered by the connectivity matrix.
+type Network struct {
+	// Name is the name of the network in the matrix. DefaultNetwork is reserved for the cluster default network.
+	Name string
+	// NADName is the name of the NetworkAttachmentDefinition of a secondary network. It must be empty for
+	// the default network.
+	NADName string
+	// NADNamespace is the namespace of the NetworkAttachmentDefinition. The probe namespace is used if empty.
+	NADNamespace string
+}
+
+// ProbeResult is a single cell of the connectivity matrix.
+type ProbeResult struct {
+	SourceNode string
+	TargetNode string
+	Network    string
+	TargetIP   string
+	Reachable  bool
+	// Error contains the reason why the probe could not be run or failed.
+	Error error
+}
+
+// Matrix is the result of a connectivity matrix run.
+type Matrix struct {
+	Results []ProbeResult
+}
+
+// Passed returns true when every probe of the matrix succeeded.
+func (matrix *Matrix) Passed() bool {
+	return matrix != nil && len(matrix.Failed()) == 0
+}
+
+// Failed returns the probes of the matrix that did not succeed.
+func (matrix *Matrix) Failed() []ProbeResult {
+	if matrix == nil {
+		return nil
+	}
+
+	var failed []ProbeResult
+
+	for _, result := range matrix.Results {
+		if !result.Reachable {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// Errors returns the aggregated errors of the failed probes.
+func (matrix *Matrix) Errors() error {
+	var errs []error
+
+	for _, result := range matrix.Failed() {
+		errs = append(errs, fmt.Errorf("%s -> %s over %s (%s): %w",
+			result.SourceNode, result.TargetNode, result.Network, result.TargetIP, result.Error))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// DefaultNetworkOnly returns the network list covering only the cluster default network.
+func DefaultNetworkOnly() []Network {
+	return []Network{{Name: DefaultNetwork}}
+}
+
+// RunConnectivityMatrix spawns a probe pod on each of the given nodes attached to all secondary networks,
+// pings every other probe pod over every network and returns the typed result matrix. The probe image must
+// provide ping. Probe pods are removed before returning.
+func RunConnectivityMatrix(
+	apiClient *clients.Settings,
+	nsname string,
+	image string,
+	nodeNames []string,
+	networks []Network,
+	timeout time.Duration) (*Matrix, error) {
+	glog.V(100).Infof("Running connectivity matrix in namespace %s across nodes %v", nsname, nodeNames)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to run connectivity matrix, 'apiClient' parameter is nil")
+	}
+
+	if len(nodeNames) < 2 {
+		glog.V(100).Infof("Less than two nodes given")
+
+		return nil, fmt.Errorf("failed to run connectivity matrix, at least two nodes are required")
+	}
+
+	if len(networks) == 0 {
+		glog.V(100).Infof("The network list is empty")
+
+		return nil, fmt.Errorf("failed to run connectivity matrix, 'networks' parameter is empty")
+	}
+
+	secondaryNetworks := secondaryNetworkSelection(networks, nsname)
+	probePods := make(map[string]*pod.Builder)
+
+	defer func() {
+		for nodeName, probePod := range probePods {
+			if _, err := probePod.DeleteAndWait(timeout); err != nil {
+				glog.V(100).Infof("Failed to remove probe pod on node %s: %s", nodeName, err.Error())
+			}
+		}
+	}()
+
+	for index, nodeName := range nodeNames {
+		probePod := pod.NewBuilder(apiClient, fmt.Sprintf("%s-%d", probePodPrefix, index), nsname, image).
+			DefineOnNode(nodeName)
+
+		if len(secondaryNetworks) > 0 {
+			probePod.WithSecondaryNetwork(secondaryNetworks)
+		}
+
+		probePod, err := probePod.CreateAndWaitUntilRunning(timeout)
+		if probePod != nil && probePod.Object != nil {
+			probePods[nodeName] = probePod
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create probe pod on node %s: %w", nodeName, err)
+		}
+	}
+
+	// The pod status and network status annotation are only populated once the pods are running.
+	for nodeName, probePod := range probePods {
+		if !probePod.Exists() {
+			return nil, fmt.Errorf("probe pod on node %s disappeared", nodeName)
+		}
+	}
+
+	return probeAll(probePods, nodeNames, networks, nsname)
+}
+
+// secondaryNetworkSelection returns the multus selection attaching the probe pods to every secondary network.
+func secondaryNetworkSelection(networks []Network, nsname string) []*multus.NetworkSelectionElement {
+	var secondaryNetworks []*multus.NetworkSelectionElement
+
+	for _, network := range networks {
+		if network.Name == DefaultNetwork || network.NADName == "" {
+			continue
+		}
+
+		nadNamespace := network.NADNamespace
+		if nadNamespace == "" {
+			nadNamespace = nsname
+		}
+
+		secondaryNetworks = append(secondaryNetworks,
+			&multus.NetworkSelectionElement{Name: network.NADName, Namespace: nadNamespace})
+	}
+
+	return secondaryNetworks
+}
+
+// probeAll pings every probe pod from every other probe pod over every network and returns the result matrix.
+func probeAll(
+	probePods map[string]*pod.Builder, nodeNames []string, networks []Network, nsname string) (*Matrix, error) {
+	matrix := &Matrix{}
+
+	for _, network := range networks {
+		targetIPs := make(map[string]string)
+
+		for nodeName, probePod := range probePods {
+			targetIP, err := getPodIP(probePod, network, nsname)
+			if err != nil {
+				return nil, err
+			}
+
+			targetIPs[nodeName] = targetIP
+		}
+
+		for _, sourceNode := range nodeNames {
+			for _, targetNode := range nodeNames {
+				if sourceNode == targetNode {
+					continue
+				}
+
+				matrix.Results = append(matrix.Results,
+					probe(probePods[sourceNode], sourceNode, targetNode, network.Name, targetIPs[targetNode]))
+			}
+		}
+	}
+
+	return matrix, nil
+}
+
+// probe pings targetIP from the source probe pod.
+func probe(sourcePod *pod.Builder, sourceNode, targetNode, network, targetIP string) ProbeResult {
+	result := ProbeResult{
+		SourceNode: sourceNode,
+		TargetNode: targetNode,
+		Network:    network,
+		TargetIP:   targetIP,
+	}
+
+	output, err := sourcePod.ExecCommand([]string{"ping", "-c", probePingCount, "-W", "2", targetIP})
+	if err != nil {
+		glog.V(100).Infof("Ping from %s to %s over %s failed: %s", sourceNode, targetNode, network, output.String())
+
+		result.Error = err
+
+		return result
+	}
+
+	result.Reachable = true
+
+	return result
+}
+
+// getPodIP returns the IP of the probe pod on the given network.
+func getPodIP(probePod *pod.Builder, network Network, nsname string) (string, error) {
+	if network.Name == DefaultNetwork || network.NADName == "" {
+		if probePod.Object.Status.PodIP == "" {
+			return "", fmt.Errorf("probe pod %s has no IP on the default network", probePod.Object.Name)
+		}
+
+		return probePod.Object.Status.PodIP, nil
+	}
+
+	nadNamespace := network.NADNamespace
+	if nadNamespace == "" {
+		nadNamespace = nsname
+	}
+
+	var networkStatuses []netAttDefV1.NetworkStatus
+
+	err := json.Unmarshal([]byte(probePod.Object.Annotations[netAttDefV1.NetworkStatusAnnot]), &networkStatuses)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse network status of probe pod %s: %w", probePod.Object.Name, err)
+	}
+
+	for _, networkStatus := range networkStatuses {
+		if networkStatus.Name != fmt.Sprintf("%s/%s", nadNamespace, network.NADName) {
+			continue
+		}
+
+		for _, address := range networkStatus.IPs {
+			if net.ParseIP(address) != nil {
+				return address, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("probe pod %s has no IP on network %s", probePod.Object.Name, network.Name)
+}
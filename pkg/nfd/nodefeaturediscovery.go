@@ -47,14 +47,14 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 		glog.V(100).Infof(
 			"Error initializing NodeFeatureDiscovery from alm-examples: %s", err.Error())
 
-		builder.errorMsg = fmt.Sprintf("Error initializing NodeFeatureDiscovery from alm-examples: %s",
-			err.Error())
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf(
+			"Error initializing NodeFeatureDiscovery from alm-examples: %s", err.Error()))
 	}
 
 	if builder.Definition == nil {
 		glog.V(100).Infof("The NodeFeatureDiscovery object definition is nil")
 
-		builder.errorMsg = "NodeFeatureDiscovery definition is nil"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "NodeFeatureDiscovery definition is nil")
 	}
 
 	return &builder
@@ -102,13 +102,13 @@ func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error)
 	if name == "" {
 		glog.V(100).Infof("NodeFeatureDiscovery name is empty")
 
-		builder.errorMsg = "NodeFeatureDiscovery 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "NodeFeatureDiscovery 'name' cannot be empty")
 	}
 
 	if namespace == "" {
 		glog.V(100).Infof("NodeFeatureDiscovery namespace is empty")
 
-		builder.errorMsg = "NodeFeatureDiscovery 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "NodeFeatureDiscovery 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -2,6 +2,7 @@ package nmstate
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/golang/glog"
 	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
@@ -12,6 +13,12 @@ import (
 func ListPolicy(apiClient *clients.Settings) ([]*PolicyBuilder, error) {
 	glog.V(100).Infof("Listing NodeNetworkConfigurationPolicy")
 
+	if apiClient == nil {
+		glog.V(100).Infof("NodeNetworkConfigurationPolicy 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list NodeNetworkConfigurationPolicy, 'apiClient' parameter is empty")
+	}
+
 	policyList := &nmstateV1.NodeNetworkConfigurationPolicyList{}
 	err := apiClient.Client.List(context.Background(), policyList)
 
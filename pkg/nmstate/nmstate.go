@@ -45,7 +45,7 @@ func NewBuilder(apiClient *clients.Settings, name string) *Builder {
 	if name == "" {
 		glog.V(100).Infof("The name of the NMState is empty")
 
-		builder.errorMsg = "NMState 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "NMState 'name' cannot be empty")
 	}
 
 	return &builder
@@ -176,7 +176,7 @@ func PullNMstate(apiClient *clients.Settings, name string) (*Builder, error) {
 	if name == "" {
 		glog.V(100).Infof("The name of the NMState is empty")
 
-		builder.errorMsg = "NMState 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "NMState 'name' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -202,13 +202,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -195,7 +195,7 @@ func PullNodeNetworkState(apiClient *clients.Settings, name string) (*StateBuild
 	if name == "" {
 		glog.V(100).Infof("The name of the NodeNetworkState is empty")
 
-		stateBuilder.errorMsg = "NodeNetworkState 'name' cannot be empty"
+		stateBuilder.errorMsg = msg.AppendErrorMsg(stateBuilder.errorMsg, "NodeNetworkState 'name' cannot be empty")
 	}
 
 	if !stateBuilder.Exists() {
@@ -219,13 +219,14 @@ func (builder *StateBuilder) validate() (bool, error) {
 	if builder.Object == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -12,13 +12,13 @@ import (
 	nmstateShared "github.com/nmstate/kubernetes-nmstate/api/shared"
 	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
 
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 
 	coreV1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/strings/slices"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -63,13 +63,14 @@ func NewPolicyBuilder(apiClient *clients.Settings, name string, nodeSelector map
 	if name == "" {
 		glog.V(100).Infof("The name of the NodeNetworkConfigurationPolicy is empty")
 
-		builder.errorMsg = "NodeNetworkConfigurationPolicy 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "NodeNetworkConfigurationPolicy 'name' cannot be empty")
 	}
 
 	if len(nodeSelector) == 0 {
 		glog.V(100).Infof("The nodeSelector of the NodeNetworkConfigurationPolicy is empty")
 
-		builder.errorMsg = "NodeNetworkConfigurationPolicy 'nodeSelector' cannot be empty map"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "NodeNetworkConfigurationPolicy 'nodeSelector' cannot be empty map")
 	}
 
 	return &builder
@@ -200,7 +201,7 @@ func (builder *PolicyBuilder) Update(force bool) (*PolicyBuilder, error) {
 // WithInterfaceAndVFs adds SR-IOV VF configuration to the NodeNetworkConfigurationPolicy.
 func (builder *PolicyBuilder) WithInterfaceAndVFs(sriovInterface string, numberOfVF uint8) *PolicyBuilder {
 	if valid, err := builder.validate(); !valid {
-		builder.errorMsg = err.Error()
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 		return builder
 	}
@@ -212,7 +213,7 @@ func (builder *PolicyBuilder) WithInterfaceAndVFs(sriovInterface string, numberO
 	if sriovInterface == "" {
 		glog.V(100).Infof("The sriovInterface  can not be empty string")
 
-		builder.errorMsg = "The sriovInterface is empty sting"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "The sriovInterface is empty sting")
 
 		return builder
 	}
@@ -232,7 +233,7 @@ func (builder *PolicyBuilder) WithInterfaceAndVFs(sriovInterface string, numberO
 // WithBondInterface adds Bond interface configuration to the NodeNetworkConfigurationPolicy.
 func (builder *PolicyBuilder) WithBondInterface(slavePorts []string, bondName, mode string) *PolicyBuilder {
 	if valid, err := builder.validate(); !valid {
-		builder.errorMsg = err.Error()
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 		return builder
 	}
@@ -243,13 +244,13 @@ func (builder *PolicyBuilder) WithBondInterface(slavePorts []string, bondName, m
 	if !slices.Contains(allowedBondModes, mode) {
 		glog.V(100).Infof("error to add Bond mode %s, allowed modes are %v", mode, allowedBondModes)
 
-		builder.errorMsg = "invalid Bond mode parameter"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "invalid Bond mode parameter")
 	}
 
 	if bondName == "" {
 		glog.V(100).Infof("The bondName can not be empty string")
 
-		builder.errorMsg = "The bondName is empty sting"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "The bondName is empty sting")
 	}
 
 	if builder.errorMsg != "" {
@@ -279,15 +280,19 @@ func (builder *PolicyBuilder) WithOptions(options ...AdditionalOptions) *PolicyB
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -296,7 +301,8 @@ func (builder *PolicyBuilder) WithOptions(options ...AdditionalOptions) *PolicyB
 
 // WaitUntilCondition waits for the duration of the defined timeout or until the
 // NodeNetworkConfigurationPolicy gets to a specific condition.
-func (builder *PolicyBuilder) WaitUntilCondition(condition nmstateShared.ConditionType, timeout time.Duration) error {
+func (builder *PolicyBuilder) WaitUntilCondition(
+	condition nmstateShared.ConditionType, timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -308,24 +314,39 @@ func (builder *PolicyBuilder) WaitUntilCondition(condition nmstateShared.Conditi
 		return fmt.Errorf("cannot wait for NodeNetworkConfigurationPolicy condition because it does not exist")
 	}
 
+	options = append([]await.WaitOption{await.WithInterval(retryInterval)}, options...)
+
 	// Polls every retryInterval to determine if NodeNetworkConfigurationPolicy is in desired condition.
-	var err error
+	var (
+		err          error
+		lastObserved string
+	)
 
-	return wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+	err = await.Poll(timeout, func() (bool, error) {
 		builder.Object, err = builder.Get()
 
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("condition %s missing", condition)
+
 		for _, cond := range builder.Object.Status.Conditions {
-			if cond.Type == condition && cond.Status == coreV1.ConditionTrue {
+			if cond.Type != condition {
+				continue
+			}
+
+			lastObserved = fmt.Sprintf("condition %s %s", condition, cond.Status)
+
+			if cond.Status == coreV1.ConditionTrue {
 				return true, nil
 			}
 		}
 
 		return false, nil
-	})
+	}, options...)
+
+	return builder.withTimeoutDetails(err, fmt.Sprintf("condition %s True", condition), lastObserved)
 }
 
 // CleanAllNMStatePolicies removes all NodeNetworkConfigurationPolicies.
@@ -351,6 +372,16 @@ func CleanAllNMStatePolicies(apiClient *clients.Settings) error {
 	return nil
 }
 
+// withTimeoutDetails converts a wait timeout into an await.WaitTimeoutError referencing the policy.
+func (builder *PolicyBuilder) withTimeoutDetails(err error, wanted, lastObserved string) error {
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          nmstateV1.GroupVersion.WithKind("NodeNetworkConfigurationPolicy"),
+		Name:         builder.Definition.Name,
+		Wanted:       wanted,
+		LastObserved: lastObserved,
+	})
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PolicyBuilder) validate() (bool, error) {
@@ -365,13 +396,14 @@ func (builder *PolicyBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -386,7 +418,7 @@ func (builder *PolicyBuilder) validate() (bool, error) {
 // withInterface adds given network interface to the NodeNetworkConfigurationPolicy.
 func (builder *PolicyBuilder) withInterface(networkInterface NetworkInterface) *PolicyBuilder {
 	if valid, err := builder.validate(); !valid {
-		builder.errorMsg = err.Error()
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 		return builder
 	}
@@ -401,7 +433,7 @@ func (builder *PolicyBuilder) withInterface(networkInterface NetworkInterface) *
 	if err != nil {
 		glog.V(100).Infof("Failed Unmarshal DesiredState")
 
-		builder.errorMsg = "Failed Unmarshal DesiredState"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Failed Unmarshal DesiredState")
 
 		return builder
 	}
@@ -413,7 +445,7 @@ func (builder *PolicyBuilder) withInterface(networkInterface NetworkInterface) *
 	if err != nil {
 		glog.V(100).Infof("Failed Marshal DesiredState")
 
-		builder.errorMsg = "failed to Marshal a new Desired state"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "failed to Marshal a new Desired state")
 
 		return builder
 	}
@@ -0,0 +1,171 @@
+package noderestart
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeBootState contains the boot identifier of a node at the time it was recorded.
+type NodeBootState struct {
+	NodeName string
+	BootID   string
+	// ReadySince is the last transition time of the node Ready condition. It approximates the node uptime.
+	ReadySince time.Time
+}
+
+// Snapshot contains the boot state of a set of nodes, keyed by node name.
+type Snapshot struct {
+	RecordedAt time.Time
+	Nodes      map[string]NodeBootState
+}
+
+// Result describes which nodes rebooted since a Snapshot was taken.
+type Result struct {
+	// Rebooted contains the nodes whose boot ID changed.
+	Rebooted []string
+	// NotRebooted contains the nodes whose boot ID did not change.
+	NotRebooted []string
+	// Missing contains the recorded nodes that no longer exist.
+	Missing []string
+}
+
+// Record takes a snapshot of the boot IDs of the nodes matching the given label selector.
+func Record(apiClient *clients.Settings, labelSelector string) (*Snapshot, error) {
+	glog.V(100).Infof("Recording boot IDs of nodes matching %q", labelSelector)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to record node boot IDs, 'apiClient' parameter is nil")
+	}
+
+	nodeList, err := apiClient.CoreV1Interface.Nodes().List(
+		context.TODO(), metaV1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		glog.V(100).Infof("Failed to list nodes due to %s", err.Error())
+
+		return nil, err
+	}
+
+	if len(nodeList.Items) == 0 {
+		return nil, fmt.Errorf("no nodes match the label selector %q", labelSelector)
+	}
+
+	snapshot := &Snapshot{RecordedAt: time.Now(), Nodes: make(map[string]NodeBootState)}
+
+	for _, node := range nodeList.Items {
+		snapshot.Nodes[node.Name] = getBootState(node)
+	}
+
+	return snapshot, nil
+}
+
+// Compare returns which of the recorded nodes rebooted since the snapshot was taken. Only nodes that are not found
+// are reported as missing, any other error getting a node is returned.
+func (snapshot *Snapshot) Compare(apiClient *clients.Settings) (*Result, error) {
+	if snapshot == nil {
+		return nil, fmt.Errorf("error: received nil snapshot")
+	}
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to compare node boot IDs, 'apiClient' parameter is nil")
+	}
+
+	glog.V(100).Infof("Comparing boot IDs of %d nodes with snapshot from %s", len(snapshot.Nodes), snapshot.RecordedAt)
+
+	result := &Result{}
+
+	for nodeName, recorded := range snapshot.Nodes {
+		node, err := apiClient.CoreV1Interface.Nodes().Get(context.TODO(), nodeName, metaV1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			glog.V(100).Infof("Node %s no longer exists", nodeName)
+
+			result.Missing = append(result.Missing, nodeName)
+
+			continue
+		}
+
+		if err != nil {
+			glog.V(100).Infof("Failed to get node %s due to %s", nodeName, err.Error())
+
+			return nil, err
+		}
+
+		if getBootState(*node).BootID != recorded.BootID {
+			result.Rebooted = append(result.Rebooted, nodeName)
+		} else {
+			result.NotRebooted = append(result.NotRebooted, nodeName)
+		}
+	}
+
+	sort.Strings(result.Rebooted)
+	sort.Strings(result.NotRebooted)
+	sort.Strings(result.Missing)
+
+	return result, nil
+}
+
+// VerifyExpectedReboots checks that exactly the expected nodes rebooted since the snapshot was taken. Unexpected
+// reboots, expected nodes that did not reboot, expected nodes that are not in the snapshot and missing nodes are
+// reported in the returned error.
+func (snapshot *Snapshot) VerifyExpectedReboots(apiClient *clients.Settings, expectedNodes []string) error {
+	result, err := snapshot.Compare(apiClient)
+	if err != nil {
+		return err
+	}
+
+	expected := make(map[string]bool)
+	for _, nodeName := range expectedNodes {
+		expected[nodeName] = true
+	}
+
+	var unexpected, notRebooted, notRecorded []string
+
+	for _, nodeName := range expectedNodes {
+		if _, recorded := snapshot.Nodes[nodeName]; !recorded {
+			notRecorded = append(notRecorded, nodeName)
+		}
+	}
+
+	for _, nodeName := range result.Rebooted {
+		if !expected[nodeName] {
+			unexpected = append(unexpected, nodeName)
+		}
+	}
+
+	for _, nodeName := range result.NotRebooted {
+		if expected[nodeName] {
+			notRebooted = append(notRebooted, nodeName)
+		}
+	}
+
+	if len(unexpected) == 0 && len(notRebooted) == 0 && len(notRecorded) == 0 && len(result.Missing) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("node reboots do not match expectations: unexpected reboots %v, missing reboots %v, "+
+		"expected nodes not in snapshot %v, missing nodes %v", unexpected, notRebooted, notRecorded, result.Missing)
+}
+
+// getBootState extracts the boot state of the given node.
+func getBootState(node v1.Node) NodeBootState {
+	bootState := NodeBootState{NodeName: node.Name, BootID: node.Status.NodeInfo.BootID}
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			bootState.ReadySince = condition.LastTransitionTime.Time
+		}
+	}
+
+	return bootState
+}
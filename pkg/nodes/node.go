@@ -65,8 +65,13 @@ func (builder *NodeBuilder) Update() (*NodeBuilder, error) {
 	var err error
 	builder.Object, err = builder.apiClient.CoreV1Interface.Nodes().Update(
 		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // Exists checks whether the given node exists.
@@ -94,7 +99,7 @@ func (builder *NodeBuilder) WithNewLabel(key, value string) *NodeBuilder {
 
 	if key == "" {
 		glog.V(100).Infof("Failed to apply label with an empty key to node %s", builder.Definition.Name)
-		builder.errorMsg = "error to set empty key to node"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "error to set empty key to node")
 	}
 
 	if builder.errorMsg != "" {
@@ -108,7 +113,7 @@ func (builder *NodeBuilder) WithNewLabel(key, value string) *NodeBuilder {
 		if !labelExist {
 			builder.Definition.Labels[key] = value
 		} else {
-			builder.errorMsg = fmt.Sprintf("cannot overwrite existing node label: %s", key)
+			builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf("cannot overwrite existing node label: %s", key))
 		}
 	}
 
@@ -125,15 +130,19 @@ func (builder *NodeBuilder) WithOptions(options ...AdditionalOptions) *NodeBuild
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -150,7 +159,7 @@ func (builder *NodeBuilder) RemoveLabel(key, value string) *NodeBuilder {
 
 	if key == "" {
 		glog.V(100).Infof("Failed to remove empty label's key from node %s", builder.Definition.Name)
-		builder.errorMsg = "error to remove empty key from node"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "error to remove empty key from node")
 	}
 
 	if builder.errorMsg != "" {
@@ -171,7 +180,7 @@ func (builder *NodeBuilder) ExternalIPv4Network() (string, error) {
 	glog.V(100).Infof("Collecting node's external ipv4 addresses")
 
 	if builder.Object == nil {
-		builder.errorMsg = "error to collect external networks from node"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "error to collect external networks from node")
 	}
 
 	if builder.errorMsg != "" {
@@ -203,13 +212,14 @@ func (builder *NodeBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -35,7 +36,7 @@ func NewBuilder(apiClient *clients.Settings, selector map[string]string) *Builde
 	if serialSelector == "" {
 		glog.V(100).Infof("The list of labels is empty")
 
-		builder.errorMsg = "The list of labels cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "The list of labels cannot be empty")
 	}
 
 	return builder
@@ -111,7 +112,8 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
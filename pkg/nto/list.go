@@ -2,6 +2,7 @@ package nto //nolint:misspell
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
@@ -12,6 +13,12 @@ import (
 func ListProfiles(apiClient *clients.Settings) ([]*Builder, error) {
 	glog.V(100).Infof("Listing PerformanceProfiles on cluster")
 
+	if apiClient == nil {
+		glog.V(100).Infof("PerformanceProfiles 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list PerformanceProfiles, 'apiClient' parameter is empty")
+	}
+
 	var performanceProfiles v2.PerformanceProfileList
 	err := apiClient.List(context.TODO(), &performanceProfiles)
 
@@ -56,25 +56,25 @@ func NewBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the PerformanceProfile is empty")
 
-		builder.errorMsg = "PerformanceProfile's name is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "PerformanceProfile's name is empty")
 	}
 
 	if cpuIsolated == "" {
 		glog.V(100).Infof("Isolated CPU of the PerformanceProfile is empty")
 
-		builder.errorMsg = "PerformanceProfile's 'cpuIsolated' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "PerformanceProfile's 'cpuIsolated' is empty")
 	}
 
 	if cpuReserved == "" {
 		glog.V(100).Infof("Reserved CPU of the PerformanceProfile is empty")
 
-		builder.errorMsg = "PerformanceProfile's 'cpuReserved' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "PerformanceProfile's 'cpuReserved' is empty")
 	}
 
 	if len(nodeSelector) == 0 {
 		glog.V(100).Infof("NodeSelector of the PerformanceProfile is empty")
 
-		builder.errorMsg = "PerformanceProfile's 'nodeSelector' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "PerformanceProfile's 'nodeSelector' is empty")
 	}
 
 	return builder
@@ -96,7 +96,7 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	if name == "" {
 		glog.V(100).Infof("The name of the PerformanceProfile is empty")
 
-		builder.errorMsg = "PerformanceProfile 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "PerformanceProfile 'name' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -122,13 +122,14 @@ func (builder *Builder) WithHugePages(hugePageSize string, hugePages []v2.HugePa
 		glog.V(100).Infof("'hugePageSize' has invalid parameter %s. Allowed parameters %v",
 			hugePageSize, allowedHugePageSize)
 
-		builder.errorMsg = fmt.Sprintf("'hugePageSize' argument is not in allowed list %v", allowedHugePageSize)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("'hugePageSize' argument is not in allowed list %v", allowedHugePageSize))
 	}
 
 	if len(hugePages) == 0 {
 		glog.V(100).Infof("'hugePages' argument cannot be empty")
 
-		builder.errorMsg = "'hugePageSize' argument cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'hugePageSize' argument cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -164,7 +165,7 @@ func (builder *Builder) WithMachineConfigPoolSelector(machineConfigPoolSelector
 	if len(machineConfigPoolSelector) == 0 {
 		glog.V(100).Infof("'machineConfigPoolSelector' argument cannot be empty")
 
-		builder.errorMsg = "'machineConfigPoolSelector' argument cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'machineConfigPoolSelector' argument cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -190,8 +191,8 @@ func (builder *Builder) WithNumaTopology(topologyPolicy string) *Builder {
 		glog.V(100).Infof("'allowedTopologyPolicies' has invalid parameter %s. Allowed parameters %v",
 			topologyPolicy, allowedTopologyPolicies)
 
-		builder.errorMsg = fmt.Sprintf("'allowedTopologyPolicies' argument is not in allowed list %v",
-			allowedTopologyPolicies)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf(
+			"'allowedTopologyPolicies' argument is not in allowed list %v", allowedTopologyPolicies))
 	}
 
 	if builder.errorMsg != "" {
@@ -338,13 +339,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
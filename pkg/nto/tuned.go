@@ -0,0 +1,200 @@
+package nto
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// TunedNamespace is the namespace of the node tuning operator holding the Tuned and Profile objects.
+	TunedNamespace = "openshift-cluster-node-tuning-operator"
+	// cmdlineReaderPodPrefix is the name prefix of the pods reading the kernel command line.
+	cmdlineReaderPodPrefix = "cmdline-reader"
+)
+
+// tunedProfileGVR is the resource of the per node Profile objects reporting the applied tuned profile.
+var tunedProfileGVR = schema.GroupVersionResource{Group: "tuned.openshift.io", Version: "v1", Resource: "profiles"}
+
+// TunedProfileStatus contains the tuned profile state of a node as reported by its Profile object.
+type TunedProfileStatus struct {
+	NodeName     string
+	TunedProfile string
+	Applied      bool
+	Degraded     bool
+	// Message is the message of the Degraded condition, or of the Applied condition if not degraded.
+	Message string
+}
+
+// GetTunedProfileStatus returns the tuned profile state of the given node.
+func GetTunedProfileStatus(apiClient *clients.Settings, nodeName string) (*TunedProfileStatus, error) {
+	glog.V(100).Infof("Getting tuned profile status of node %s", nodeName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to get tuned profile status, 'apiClient' parameter is nil")
+	}
+
+	if nodeName == "" {
+		glog.V(100).Infof("The node name is empty")
+
+		return nil, fmt.Errorf("failed to get tuned profile status, 'nodeName' parameter is empty")
+	}
+
+	profile, err := apiClient.Resource(tunedProfileGVR).Namespace(TunedNamespace).Get(
+		context.TODO(), nodeName, metaV1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tuned profile of node %s: %w", nodeName, err)
+	}
+
+	status := &TunedProfileStatus{NodeName: nodeName}
+
+	status.TunedProfile, _, _ = unstructured.NestedString(profile.Object, "status", "tunedProfile")
+	if status.TunedProfile == "" {
+		status.TunedProfile, _, _ = unstructured.NestedString(profile.Object, "spec", "config", "tunedProfile")
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(profile.Object, "status", "conditions")
+
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		conditionType, _, _ := unstructured.NestedString(conditionMap, "type")
+		conditionStatus, _, _ := unstructured.NestedString(conditionMap, "status")
+		message, _, _ := unstructured.NestedString(conditionMap, "message")
+
+		switch conditionType {
+		case "Applied":
+			status.Applied = conditionStatus == string(metaV1.ConditionTrue)
+
+			if status.Message == "" {
+				status.Message = message
+			}
+		case "Degraded":
+			status.Degraded = conditionStatus == string(metaV1.ConditionTrue)
+
+			if status.Degraded {
+				status.Message = message
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// WaitForProfileApplied waits for timeout duration or until the given tuned profile is applied and not degraded on
+// the given node. An empty tunedProfile accepts any applied profile.
+func WaitForProfileApplied(
+	apiClient *clients.Settings,
+	nodeName string,
+	tunedProfile string,
+	timeout time.Duration,
+	options ...await.WaitOption) error {
+	glog.V(100).Infof("Waiting for tuned profile %q to be applied on node %s", tunedProfile, nodeName)
+
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
+		status, err := GetTunedProfileStatus(apiClient, nodeName)
+		if err != nil {
+			glog.V(100).Infof("Failed to get tuned profile status: %s", err.Error())
+
+			return false, nil
+		}
+
+		lastObserved = fmt.Sprintf("profile %q, applied %t, degraded %t: %s",
+			status.TunedProfile, status.Applied, status.Degraded, status.Message)
+
+		if tunedProfile != "" && status.TunedProfile != tunedProfile {
+			return false, nil
+		}
+
+		return status.Applied && !status.Degraded, nil
+	}, options...)
+
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          schema.GroupVersionKind{Group: "tuned.openshift.io", Version: "v1", Kind: "Profile"},
+		Name:         nodeName,
+		Namespace:    TunedNamespace,
+		Wanted:       fmt.Sprintf("tuned profile %q applied", tunedProfile),
+		LastObserved: lastObserved,
+	})
+}
+
+// GetKernelArguments returns the arguments of the kernel command line of the given node. The command line is read
+// through a short-lived privileged pod running the given image in the given namespace.
+func GetKernelArguments(
+	apiClient *clients.Settings, nodeName, nsname, image string, timeout time.Duration) ([]string, error) {
+	glog.V(100).Infof("Reading kernel command line of node %s", nodeName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to read kernel command line, 'apiClient' parameter is nil")
+	}
+
+	readerPod, err := pod.NewBuilder(
+		apiClient, fmt.Sprintf("%s-%s", cmdlineReaderPodPrefix, nodeName), nsname, image).
+		DefineOnNode(nodeName).
+		WithPrivilegedFlag().
+		CreateAndWaitUntilRunning(timeout)
+
+	defer func() {
+		if _, err := readerPod.DeleteAndWait(timeout); err != nil {
+			glog.V(100).Infof("Failed to remove kernel command line reader pod on node %s: %s", nodeName, err.Error())
+		}
+	}()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kernel command line reader pod on node %s: %w", nodeName, err)
+	}
+
+	output, err := readerPod.ExecCommand([]string{"cat", "/proc/cmdline"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kernel command line on node %s: %w", nodeName, err)
+	}
+
+	return strings.Fields(output.String()), nil
+}
+
+// VerifyKernelArguments checks that every expected argument is present on the kernel command line of the given
+// node. Arguments are compared as a whole, e.g. "isolcpus=2-5" does not match "isolcpus=2-7".
+func VerifyKernelArguments(
+	apiClient *clients.Settings, nodeName, nsname, image string, expected []string, timeout time.Duration) error {
+	arguments, err := GetKernelArguments(apiClient, nodeName, nsname, image, timeout)
+	if err != nil {
+		return err
+	}
+
+	present := make(map[string]bool)
+	for _, argument := range arguments {
+		present[argument] = true
+	}
+
+	var missing []string
+
+	for _, argument := range expected {
+		if !present[argument] {
+			missing = append(missing, argument)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("kernel command line of node %s is missing arguments %v", nodeName, missing)
+	}
+
+	return nil
+}
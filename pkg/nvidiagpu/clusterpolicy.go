@@ -48,13 +48,14 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 		glog.V(100).Infof(
 			"Error initializing ClusterPolicy from alm-examples: %s", err.Error())
 
-		builder.errorMsg = fmt.Sprintf("Error initializing ClusterPolicy from alm-examples: %s", err.Error())
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("Error initializing ClusterPolicy from alm-examples: %s", err.Error()))
 	}
 
 	if builder.Definition == nil {
 		glog.V(100).Infof("The ClusterPolicy object definition is nil")
 
-		builder.errorMsg = "ClusterPolicy 'Object.Definition' is nil"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "ClusterPolicy 'Object.Definition' is nil")
 	}
 
 	return &builder
@@ -100,7 +101,7 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	if name == "" {
 		glog.V(100).Infof("ClusterPolicy name is empty")
 
-		builder.errorMsg = "ClusterPolicy 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "ClusterPolicy 'name' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -241,13 +242,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -33,6 +33,12 @@ func ListClusterServiceVersion(
 	options metaV1.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
 	glog.V(100).Infof("Listing clusterserviceversions in the namespace %s with the options %v", nsname, options)
 
+	if apiClient == nil {
+		glog.V(100).Infof("clusterserviceversions 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list clusterserviceversions, 'apiClient' parameter is empty")
+	}
+
 	if nsname == "" {
 		glog.V(100).Infof("clusterserviceversion 'nsname' parameter can not be empty")
 
@@ -79,11 +85,11 @@ func PullClusterServiceVersion(apiClient *clients.Settings, name, namespace stri
 	}
 
 	if name == "" {
-		builder.errorMsg = "clusterserviceversion 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterserviceversion 'name' cannot be empty")
 	}
 
 	if namespace == "" {
-		builder.errorMsg = "clusterserviceversion 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterserviceversion 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -133,6 +139,8 @@ func (builder *ClusterServiceVersionBuilder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -174,13 +182,14 @@ func (builder *ClusterServiceVersionBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -0,0 +1,190 @@
+package olm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	oplmV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// catalogSourceReadyState is the connection state of a catalogsource whose registry is serving.
+const catalogSourceReadyState = "READY"
+
+// OperatorHealth summarizes the health of an operator installed through a subscription.
+type OperatorHealth struct {
+	SubscriptionName      string
+	SubscriptionNamespace string
+	// InstalledCSV is empty if the subscription has not installed a clusterserviceversion yet.
+	InstalledCSV string
+	CSVPhase     oplmV1alpha1.ClusterServiceVersionPhase
+	// Deployments contains the readiness of every deployment of the installed clusterserviceversion.
+	Deployments map[string]bool
+	// CatalogSource is the catalogsource of the subscription as namespace/name.
+	CatalogSource      string
+	CatalogSourceState string
+}
+
+// Healthy checks whether the clusterserviceversion succeeded, all of its deployments are ready and the catalogsource
+// is serving.
+func (health *OperatorHealth) Healthy() bool {
+	return len(health.Problems()) == 0
+}
+
+// Problems returns a description of every unhealthy part of the operator.
+func (health *OperatorHealth) Problems() []string {
+	var problems []string
+
+	if health.InstalledCSV == "" {
+		problems = append(problems, "no clusterserviceversion installed")
+	} else if health.CSVPhase != oplmV1alpha1.CSVPhaseSucceeded {
+		problems = append(problems, fmt.Sprintf("clusterserviceversion %s in phase %q", health.InstalledCSV, health.CSVPhase))
+	}
+
+	var notReady []string
+
+	for deploymentName, ready := range health.Deployments {
+		if !ready {
+			notReady = append(notReady, deploymentName)
+		}
+	}
+
+	sort.Strings(notReady)
+
+	for _, deploymentName := range notReady {
+		problems = append(problems, fmt.Sprintf("deployment %s not ready", deploymentName))
+	}
+
+	if health.CatalogSourceState != catalogSourceReadyState {
+		problems = append(problems,
+			fmt.Sprintf("catalogsource %s in state %q", health.CatalogSource, health.CatalogSourceState))
+	}
+
+	return problems
+}
+
+// GetOperatorHealth returns the health of the operator installed by the given subscription.
+func GetOperatorHealth(apiClient *clients.Settings, subName, subNamespace string) (*OperatorHealth, error) {
+	glog.V(100).Infof("Getting health of operator installed by subscription %s in namespace %s",
+		subName, subNamespace)
+
+	subscription, err := PullSubscription(apiClient, subName, subNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return getOperatorHealth(apiClient, subscription.Object)
+}
+
+// VerifyOperatorsHealthy checks the health of the operators installed by all subscriptions in the given namespaces.
+// Every unhealthy operator is reported in the returned error.
+func VerifyOperatorsHealthy(apiClient *clients.Settings, nsnames ...string) error {
+	glog.V(100).Infof("Verifying health of operators in namespaces %v", nsnames)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return fmt.Errorf("failed to verify operators health, 'apiClient' parameter is nil")
+	}
+
+	if len(nsnames) == 0 {
+		glog.V(100).Infof("The namespaces to verify are empty")
+
+		return fmt.Errorf("failed to verify operators health, 'nsnames' parameter is empty")
+	}
+
+	var errs []error
+
+	for _, nsname := range nsnames {
+		subscriptionList, err := apiClient.OperatorsV1alpha1Interface.Subscriptions(nsname).List(
+			context.TODO(), metaV1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list subscriptions in namespace %s: %w", nsname, err)
+		}
+
+		if len(subscriptionList.Items) == 0 {
+			errs = append(errs, fmt.Errorf("no subscriptions found in namespace %s", nsname))
+
+			continue
+		}
+
+		for index := range subscriptionList.Items {
+			health, err := getOperatorHealth(apiClient, &subscriptionList.Items[index])
+			if err != nil {
+				return err
+			}
+
+			if problems := health.Problems(); len(problems) > 0 {
+				errs = append(errs, fmt.Errorf("operator of subscription %s/%s is unhealthy: %s",
+					nsname, health.SubscriptionName, strings.Join(problems, ", ")))
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// getOperatorHealth collects the health of the operator installed by the given subscription.
+func getOperatorHealth(
+	apiClient *clients.Settings, subscription *oplmV1alpha1.Subscription) (*OperatorHealth, error) {
+	health := &OperatorHealth{
+		SubscriptionName:      subscription.Name,
+		SubscriptionNamespace: subscription.Namespace,
+		InstalledCSV:          subscription.Status.InstalledCSV,
+		Deployments:           make(map[string]bool),
+	}
+
+	if subscription.Spec != nil {
+		health.CatalogSource = fmt.Sprintf("%s/%s",
+			subscription.Spec.CatalogSourceNamespace, subscription.Spec.CatalogSource)
+
+		catalogSource, err := apiClient.OperatorsV1alpha1Interface.CatalogSources(
+			subscription.Spec.CatalogSourceNamespace).Get(
+			context.TODO(), subscription.Spec.CatalogSource, metaV1.GetOptions{})
+		if err != nil {
+			glog.V(100).Infof("Failed to get catalogsource %s due to %s", health.CatalogSource, err.Error())
+		} else if catalogSource.Status.GRPCConnectionState != nil {
+			health.CatalogSourceState = catalogSource.Status.GRPCConnectionState.LastObservedState
+		}
+	}
+
+	if health.InstalledCSV == "" {
+		return health, nil
+	}
+
+	csv, err := PullClusterServiceVersion(apiClient, health.InstalledCSV, subscription.Namespace)
+	if err != nil {
+		glog.V(100).Infof("Failed to pull clusterserviceversion %s due to %s", health.InstalledCSV, err.Error())
+
+		return health, nil
+	}
+
+	health.CSVPhase = csv.Object.Status.Phase
+
+	for _, deploymentSpec := range csv.Object.Spec.InstallStrategy.StrategySpec.DeploymentSpecs {
+		deployment, err := apiClient.AppsV1Interface.Deployments(subscription.Namespace).Get(
+			context.TODO(), deploymentSpec.Name, metaV1.GetOptions{})
+		if err != nil {
+			glog.V(100).Infof("Failed to get deployment %s due to %s", deploymentSpec.Name, err.Error())
+
+			health.Deployments[deploymentSpec.Name] = false
+
+			continue
+		}
+
+		desiredReplicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desiredReplicas = *deployment.Spec.Replicas
+		}
+
+		health.Deployments[deploymentSpec.Name] = deployment.Status.ReadyReplicas == desiredReplicas &&
+			deployment.Status.UpdatedReplicas == desiredReplicas
+	}
+
+	return health, nil
+}
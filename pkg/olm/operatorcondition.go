@@ -0,0 +1,152 @@
+package olm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	oplmV2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OperatorConditionBuilder provides a struct for operatorcondition object
+// from the cluster and an operatorcondition definition.
+type OperatorConditionBuilder struct {
+	// OperatorCondition definition. Used to store the operatorcondition object.
+	Definition *oplmV2.OperatorCondition
+	// Created OperatorCondition object on the cluster.
+	Object *oplmV2.OperatorCondition
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// errorMsg is processed before OperatorCondition object is used.
+	errorMsg string
+}
+
+// PullOperatorCondition loads an existing operatorcondition into Builder struct. The operatorcondition is named
+// after the clusterserviceversion it belongs to.
+func PullOperatorCondition(apiClient *clients.Settings, name, namespace string) (*OperatorConditionBuilder, error) {
+	glog.V(100).Infof("Pulling existing operatorcondition name %s in namespace %s", name, namespace)
+
+	builder := OperatorConditionBuilder{
+		apiClient: apiClient,
+		Definition: &oplmV2.OperatorCondition{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		},
+	}
+
+	if name == "" {
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "operatorcondition 'name' cannot be empty")
+	}
+
+	if namespace == "" {
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "operatorcondition 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("operatorcondition object %s doesn't exist in namespace %s", name, namespace)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Exists checks whether the given operatorcondition exists.
+func (builder *OperatorConditionBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	glog.V(100).Infof(
+		"Checking if operatorcondition %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	operatorCondition := &oplmV2.OperatorCondition{}
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, operatorCondition)
+
+	if err != nil {
+		builder.Object = nil
+
+		return false
+	}
+
+	builder.Object = operatorCondition
+
+	return true
+}
+
+// GetCondition returns the condition of the given type the operator reports. Overrides set by the cluster
+// administrator take precedence over the conditions set by the operator. It returns nil if the condition is not set.
+func (builder *OperatorConditionBuilder) GetCondition(conditionType string) (*metaV1.Condition, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting condition %s of operatorcondition %s in namespace %s",
+		conditionType, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("operatorcondition object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if condition := meta.FindStatusCondition(builder.Object.Spec.Overrides, conditionType); condition != nil {
+		return condition, nil
+	}
+
+	return meta.FindStatusCondition(builder.Object.Spec.Conditions, conditionType), nil
+}
+
+// IsUpgradeable checks whether the operator allows being upgraded. An operator not reporting the Upgradeable
+// condition is considered upgradeable.
+func (builder *OperatorConditionBuilder) IsUpgradeable() (bool, error) {
+	condition, err := builder.GetCondition(oplmV2.Upgradeable)
+	if err != nil {
+		return false, err
+	}
+
+	return condition == nil || condition.Status == metaV1.ConditionTrue, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *OperatorConditionBuilder) validate() (bool, error) {
+	resourceCRD := "OperatorCondition"
+
+	if builder == nil {
+		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		glog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if builder.errorMsg != "" {
+		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -49,13 +49,13 @@ func NewOperatorGroupBuilder(apiClient *clients.Settings, groupName, nsName stri
 	if groupName == "" {
 		glog.V(100).Infof("The Name of the OperatorGroup is empty")
 
-		builder.errorMsg = "OperatorGroup 'groupName' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "OperatorGroup 'groupName' cannot be empty")
 	}
 
 	if nsName == "" {
 		glog.V(100).Infof("The Namespace of the OperatorGroup is empty")
 
-		builder.errorMsg = "OperatorGroup 'Namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "OperatorGroup 'Namespace' cannot be empty")
 	}
 
 	return builder
@@ -74,6 +74,9 @@ func (builder *OperatorGroupBuilder) Create() (*OperatorGroupBuilder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.OperatorGroups(builder.Definition.Namespace).Create(context.TODO(),
 			builder.Definition, metav1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -116,6 +119,8 @@ func (builder *OperatorGroupBuilder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -133,8 +138,13 @@ func (builder *OperatorGroupBuilder) Update() (*OperatorGroupBuilder, error) {
 	var err error
 	builder.Object, err = builder.apiClient.OperatorGroups(builder.Definition.Namespace).Update(
 		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // PullOperatorGroup loads existing OperatorGroup from cluster into the OperatorGroupBuilder struct.
@@ -156,13 +166,13 @@ func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*
 	if groupName == "" {
 		glog.V(100).Infof("The name of the OperatorGroup is empty")
 
-		builder.errorMsg = "OperatorGroup 'Name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "OperatorGroup 'Name' cannot be empty")
 	}
 
 	if nsName == "" {
 		glog.V(100).Infof("The namespace of the OperatorGroup is empty")
 
-		builder.errorMsg = "OperatorGroup 'Namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "OperatorGroup 'Namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -188,13 +198,14 @@ func (builder *OperatorGroupBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
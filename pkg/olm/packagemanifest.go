@@ -33,6 +33,12 @@ func ListPackageManifest(
 	options metaV1.ListOptions) ([]*PackageManifestBuilder, error) {
 	glog.V(100).Infof("Listing PackageManifests in the namespace %s with the options %v", nsname, options)
 
+	if apiClient == nil {
+		glog.V(100).Infof("packagemanifests 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list packagemanifests, 'apiClient' parameter is empty")
+	}
+
 	if nsname == "" {
 		glog.V(100).Infof("packagemanifest 'nsname' parameter can not be empty")
 
@@ -82,13 +88,13 @@ func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*Pac
 	if name == "" {
 		glog.V(100).Infof("The Name of the PackageManifest is empty")
 
-		builder.errorMsg = "PackageManifest 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "PackageManifest 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The Namespace of the PackageManifest is empty")
 
-		builder.errorMsg = "PackageManifest 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "PackageManifest 'nsname' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -169,6 +175,8 @@ func (builder *PackageManifestBuilder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -188,13 +196,14 @@ func (builder *PackageManifestBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -51,31 +51,31 @@ func NewSubscriptionBuilder(apiClient *clients.Settings, subName, subNamespace,
 	if subName == "" {
 		glog.V(100).Infof("The Name of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'subName' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Subscription 'subName' cannot be empty")
 	}
 
 	if subNamespace == "" {
 		glog.V(100).Infof("The Namespace of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'subNamespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Subscription 'subNamespace' cannot be empty")
 	}
 
 	if catalogSource == "" {
 		glog.V(100).Infof("The Catalogsource of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'catalogSource' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Subscription 'catalogSource' cannot be empty")
 	}
 
 	if catalogSourceNamespace == "" {
 		glog.V(100).Infof("The Catalogsource namespace of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'catalogSourceNamespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Subscription 'catalogSourceNamespace' cannot be empty")
 	}
 
 	if packageName == "" {
 		glog.V(100).Infof("The Package name of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'packageName' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Subscription 'packageName' cannot be empty")
 	}
 
 	return builder
@@ -90,7 +90,7 @@ func (builder *SubscriptionBuilder) WithChannel(channel string) *SubscriptionBui
 	glog.V(100).Infof("Defining Subscription builder object with channel: %s", channel)
 
 	if channel == "" {
-		builder.errorMsg = "can not redefine subscription with empty channel"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not redefine subscription with empty channel")
 	}
 
 	if builder.errorMsg != "" {
@@ -112,7 +112,7 @@ func (builder *SubscriptionBuilder) WithStartingCSV(startingCSV string) *Subscri
 		startingCSV)
 
 	if startingCSV == "" {
-		builder.errorMsg = "can not redefine subscription with empty startingCSV"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not redefine subscription with empty startingCSV")
 	}
 
 	if builder.errorMsg != "" {
@@ -138,7 +138,8 @@ func (builder *SubscriptionBuilder) WithInstallPlanApproval(
 		glog.V(100).Infof("The InstallPlanApproval of the Subscription must be either \"Automatic\" " +
 			"or \"Manual\"")
 
-		builder.errorMsg = "Subscription 'installPlanApproval' must be either \"Automatic\" or \"Manual\""
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "Subscription 'installPlanApproval' must be either \"Automatic\" or \"Manual\"")
 	}
 
 	if builder.errorMsg != "" {
@@ -163,6 +164,9 @@ func (builder *SubscriptionBuilder) Create() (*SubscriptionBuilder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Subscriptions(builder.Definition.Namespace).Create(context.TODO(),
 			builder.Definition, metav1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -205,6 +209,8 @@ func (builder *SubscriptionBuilder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -228,8 +234,13 @@ func (builder *SubscriptionBuilder) Update() (*SubscriptionBuilder, error) {
 
 	builder.Object, err = builder.apiClient.Subscriptions(builder.Definition.Namespace).Update(
 		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // PullSubscription loads existing Subscription from cluster into the SubscriptionBuilder struct.
@@ -250,13 +261,13 @@ func PullSubscription(apiClient *clients.Settings, subName, subNamespace string)
 	if subName == "" {
 		glog.V(100).Infof("The name of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'subName' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Subscription 'subName' cannot be empty")
 	}
 
 	if subNamespace == "" {
 		glog.V(100).Infof("The namespace of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'subNamespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Subscription 'subNamespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -282,13 +293,14 @@ func (builder *SubscriptionBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -6,6 +6,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/utils/strings/slices"
 )
@@ -55,19 +56,19 @@ func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
 	if name == "" {
 		glog.V(100).Infof("The name of the container is empty")
 
-		builder.errorMsg = "container's name is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's name is empty")
 	}
 
 	if image == "" {
 		glog.V(100).Infof("Container's image is empty")
 
-		builder.errorMsg = "container's image is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's image is empty")
 	}
 
 	if len(cmd) < 1 {
 		glog.V(100).Infof("Container's cmd is empty")
 
-		builder.errorMsg = "container's cmd is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's cmd is empty")
 	}
 
 	return builder
@@ -82,7 +83,7 @@ func (builder *ContainerBuilder) WithSecurityCapabilities(sCapabilities []string
 		if !redefine {
 			glog.V(100).Infof("Cannot modify pre-existing SecurityContext")
 
-			builder.errorMsg = "can not modify pre-existing security context"
+			builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not modify pre-existing security context")
 		}
 
 		builder.definition.SecurityContext = nil
@@ -92,7 +93,8 @@ func (builder *ContainerBuilder) WithSecurityCapabilities(sCapabilities []string
 		glog.V(100).Infof("Given SecurityCapabilities %v are not valid. Valid list %s",
 			sCapabilities, AllowedSCList)
 
-		builder.errorMsg = "one of the give securityCapabilities is invalid. Please extend allowed list or fix parameter"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "one of the give securityCapabilities is invalid. Please extend allowed list or fix parameter")
 	}
 
 	if builder.errorMsg != "" {
@@ -120,7 +122,7 @@ func (builder *ContainerBuilder) WithSecurityContext(securityContext *v1.Securit
 	if securityContext == nil {
 		glog.V(100).Infof("Cannot add empty securityContext to container structure")
 
-		builder.errorMsg = "can not modify container config with empty securityContext"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not modify container config with empty securityContext")
 	}
 
 	if builder.errorMsg != "" {
@@ -140,19 +142,19 @@ func (builder *ContainerBuilder) WithResourceLimit(hugePages, memory string, cpu
 	if hugePages == "" {
 		glog.V(100).Infof("Container's resource limit hugePages is empty")
 
-		builder.errorMsg = "container's resource limit 'hugePages' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's resource limit 'hugePages' is empty")
 	}
 
 	if memory == "" {
 		glog.V(100).Infof("Container's resource limit memory is empty")
 
-		builder.errorMsg = "container's resource limit 'memory' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's resource limit 'memory' is empty")
 	}
 
 	if cpu <= 0 {
 		glog.V(100).Infof("Container's resource limit cpu can not be zero or negative number.")
 
-		builder.errorMsg = "container's resource limit 'cpu' is invalid"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's resource limit 'cpu' is invalid")
 	}
 
 	if builder.errorMsg != "" {
@@ -176,19 +178,19 @@ func (builder *ContainerBuilder) WithResourceRequest(hugePages, memory string, c
 	if hugePages == "" {
 		glog.V(100).Infof("Container's resource request hugePages is empty")
 
-		builder.errorMsg = "container's resource request 'hugePages' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's resource request 'hugePages' is empty")
 	}
 
 	if memory == "" {
 		glog.V(100).Infof("Container's resource request memory is empty")
 
-		builder.errorMsg = "container's resource request 'memory' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's resource request 'memory' is empty")
 	}
 
 	if cpu <= 0 {
 		glog.V(100).Infof("Container's resource request cpu can not be zero or negative number.")
 
-		builder.errorMsg = "container's resource request 'cpu' is invalid"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's resource request 'cpu' is invalid")
 	}
 
 	if builder.errorMsg != "" {
@@ -211,13 +213,13 @@ func (builder *ContainerBuilder) WithEnvVar(name, value string) *ContainerBuilde
 	if name == "" {
 		glog.V(100).Infof("Container's environment var 'name' is empty")
 
-		builder.errorMsg = "container's environment var 'name' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's environment var 'name' is empty")
 	}
 
 	if value == "" {
 		glog.V(100).Infof("Container's environment var 'value' is empty")
 
-		builder.errorMsg = "container's environment var 'value' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "container's environment var 'value' is empty")
 	}
 
 	if builder.errorMsg != name {
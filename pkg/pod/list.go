@@ -6,14 +6,24 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// bulkWaitConcurrency is the number of pods waited for at the same time by the bulk wait functions.
+const bulkWaitConcurrency = 10
+
 // List returns pod inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options v1.ListOptions) ([]*Builder, error) {
 	glog.V(100).Infof("Listing pods in the nsname %s with the options %v", nsname, options)
 
+	if apiClient == nil {
+		glog.V(100).Infof("pods 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list pods, 'apiClient' parameter is empty")
+	}
+
 	if nsname == "" {
 		glog.V(100).Infof("pod 'nsname' parameter can not be empty")
 
@@ -48,6 +58,12 @@ func List(apiClient *clients.Settings, nsname string, options v1.ListOptions) ([
 func ListInAllNamespaces(apiClient *clients.Settings, options v1.ListOptions) ([]*Builder, error) {
 	glog.V(100).Infof("Listing all pods with the options %v", options)
 
+	if apiClient == nil {
+		glog.V(100).Infof("pods 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list pods, 'apiClient' parameter is empty")
+	}
+
 	podList, err := apiClient.Pods("").List(context.Background(), options)
 
 	if err != nil {
@@ -78,6 +94,7 @@ func WaitForAllPodsInNamespaceRunning(
 	nsname string,
 	options v1.ListOptions,
 	timeout time.Duration,
+	waitOptions ...await.WaitOption,
 ) (bool, error) {
 	glog.V(100).Infof("Waiting for all pods in %s namespace with %v options are in running state", nsname, options)
 
@@ -94,14 +111,51 @@ func WaitForAllPodsInNamespaceRunning(
 		return false, err
 	}
 
+	waitFuncs := make([]await.WaitFunc, 0, len(podList))
+
 	for _, podObj := range podList {
-		err = podObj.WaitUntilRunning(timeout)
-		if err != nil {
-			glog.V(100).Infof("Timout was reached while waiting for all pods in running state: %s", err.Error())
+		podObj := podObj
+		waitFuncs = append(waitFuncs, await.WaitFunc{
+			Name: podObj.Definition.Name,
+			Wait: func(timeout time.Duration) error {
+				return podObj.WaitUntilRunning(timeout, waitOptions...)
+			},
+		})
+	}
 
-			return false, err
-		}
+	err = await.AllWithConcurrency(timeout, bulkWaitConcurrency, waitFuncs...)
+	if err != nil {
+		glog.V(100).Infof("Timout was reached while waiting for all pods in running state: %s", err.Error())
+
+		return false, err
 	}
 
 	return true, nil
 }
+
+// DeleteAllOf removes all pods in the given namespace matching options with a single DeleteCollection call. The call
+// does not wait for the pods to terminate.
+func DeleteAllOf(apiClient *clients.Settings, nsname string, options v1.ListOptions) error {
+	glog.V(100).Infof("Deleting all pods in the nsname %s with the options %v", nsname, options)
+
+	if apiClient == nil {
+		glog.V(100).Infof("pods 'apiClient' parameter can not be empty")
+
+		return fmt.Errorf("failed to delete pods, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("pod 'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to delete pods, 'nsname' parameter is empty")
+	}
+
+	err := apiClient.Pods(nsname).DeleteCollection(context.TODO(), v1.DeleteOptions{}, options)
+	if err != nil {
+		glog.V(100).Infof("Failed to delete pods in the nsname %s due to %s", nsname, err.Error())
+
+		return err
+	}
+
+	return nil
+}
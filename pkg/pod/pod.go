@@ -18,7 +18,6 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
@@ -26,6 +25,7 @@ import (
 
 	"github.com/golang/glog"
 
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 )
@@ -60,19 +60,19 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	if name == "" {
 		glog.V(100).Infof("The name of the pod is empty")
 
-		builder.errorMsg = "pod's name is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "pod's name is empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the pod is empty")
 
-		builder.errorMsg = "namespace's name is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "namespace's name is empty")
 	}
 
 	if image == "" {
 		glog.V(100).Infof("The image of the pod is empty")
 
-		builder.errorMsg = "pod's image is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "pod's image is empty")
 	}
 
 	defaultContainer, err := NewContainerBuilder("test", image, []string{"/bin/bash", "-c", "sleep INF"}).GetContainerCfg()
@@ -80,7 +80,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	if err != nil {
 		glog.V(100).Infof("Failed to define the default container settings")
 
-		builder.errorMsg = err.Error()
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 	}
 
 	builder.Definition.Spec.Containers = append(builder.Definition.Spec.Containers, *defaultContainer)
@@ -105,13 +105,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if name == "" {
 		glog.V(100).Infof("The name of the pod is empty")
 
-		builder.errorMsg = "pod 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "pod 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the pod is empty")
 
-		builder.errorMsg = "pod 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "pod 'namespace' cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -142,14 +142,14 @@ func (builder *Builder) DefineOnNode(nodeName string) *Builder {
 	if builder.Object != nil {
 		glog.V(100).Infof("The pod is already running on node %s", builder.Object.Spec.NodeName)
 
-		builder.errorMsg = fmt.Sprintf(
-			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf(
+			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName))
 	}
 
 	if nodeName == "" {
 		glog.V(100).Infof("The node name is empty")
 
-		builder.errorMsg = "can not define pod on empty node"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not define pod on empty node")
 	}
 
 	if builder.errorMsg == "" {
@@ -172,6 +172,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -197,13 +200,15 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, fmt.Errorf("can not delete pod: %w", err)
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return builder, nil
 }
 
 // DeleteAndWait deletes the pod object and waits until the pod is deleted.
-func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
+func (builder *Builder) DeleteAndWait(timeout time.Duration, options ...await.WaitOption) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -216,7 +221,7 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
 		return builder, err
 	}
 
-	err = builder.WaitUntilDeleted(timeout)
+	err = builder.WaitUntilDeleted(timeout, options...)
 
 	if err != nil {
 		return builder, err
@@ -226,7 +231,8 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
 }
 
 // CreateAndWaitUntilRunning creates the pod object and waits until the pod is running.
-func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Builder, error) {
+func (builder *Builder) CreateAndWaitUntilRunning(
+	timeout time.Duration, options ...await.WaitOption) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -239,7 +245,7 @@ func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Build
 		return builder, err
 	}
 
-	err = builder.WaitUntilRunning(timeout)
+	err = builder.WaitUntilRunning(timeout, options...)
 
 	if err != nil {
 		return builder, err
@@ -249,7 +255,7 @@ func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Build
 }
 
 // WaitUntilRunning waits for the duration of the defined timeout or until the pod is running.
-func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
+func (builder *Builder) WaitUntilRunning(timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -257,11 +263,12 @@ func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is running",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	return builder.WaitUntilInStatus(v1.PodRunning, timeout)
+	return builder.WaitUntilInStatus(v1.PodRunning, timeout, options...)
 }
 
 // WaitUntilInStatus waits for the duration of the defined timeout or until the pod gets to a specific status.
-func (builder *Builder) WaitUntilInStatus(status v1.PodPhase, timeout time.Duration) error {
+func (builder *Builder) WaitUntilInStatus(
+	status v1.PodPhase, timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -269,19 +276,25 @@ func (builder *Builder) WaitUntilInStatus(status v1.PodPhase, timeout time.Durat
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has status %v",
 		builder.Definition.Name, builder.Definition.Namespace, status)
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
 		updatePod, err := builder.apiClient.Pods(builder.Object.Namespace).Get(
 			context.Background(), builder.Object.Name, metaV1.GetOptions{})
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("phase %s", updatePod.Status.Phase)
+
 		return updatePod.Status.Phase == status, nil
-	})
+	}, options...)
+
+	return builder.withTimeoutDetails(err, fmt.Sprintf("phase %s", status), lastObserved)
 }
 
 // WaitUntilDeleted waits for the duration of the defined timeout or until the pod is deleted.
-func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
+func (builder *Builder) WaitUntilDeleted(timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -289,10 +302,14 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is deleted",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	err := wait.Poll(time.Second, timeout, func() (bool, error) {
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
 		_, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
 			context.Background(), builder.Definition.Name, metaV1.GetOptions{})
 		if err == nil {
+			lastObserved = "object still present"
+
 			glog.V(100).Infof("pod %s/%s still present", builder.Definition.Namespace, builder.Definition.Name)
 
 			return false, nil
@@ -305,13 +322,13 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 		glog.V(100).Infof("failed to get pod %s/%s: %v", builder.Definition.Namespace, builder.Definition.Name, err)
 
 		return false, err
-	})
+	}, options...)
 
-	return err
+	return builder.withTimeoutDetails(err, "deletion", lastObserved)
 }
 
 // WaitUntilReady waits for the duration of the defined timeout or until the pod reaches the Ready condition.
-func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
+func (builder *Builder) WaitUntilReady(timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -319,11 +336,12 @@ func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is Ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	return builder.WaitUntilCondition(v1.PodReady, timeout)
+	return builder.WaitUntilCondition(v1.PodReady, timeout, options...)
 }
 
 // WaitUntilCondition waits for the duration of the defined timeout or until the pod gets to a specific condition.
-func (builder *Builder) WaitUntilCondition(condition v1.PodConditionType, timeout time.Duration) error {
+func (builder *Builder) WaitUntilCondition(
+	condition v1.PodConditionType, timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -331,21 +349,43 @@ func (builder *Builder) WaitUntilCondition(condition v1.PodConditionType, timeou
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has condition %v",
 		builder.Definition.Name, builder.Definition.Namespace, condition)
 
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
 		updatePod, err := builder.apiClient.Pods(builder.Object.Namespace).Get(
 			context.Background(), builder.Object.Name, metaV1.GetOptions{})
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("condition %s missing", condition)
+
 		for _, cond := range updatePod.Status.Conditions {
-			if cond.Type == condition && cond.Status == v1.ConditionTrue {
+			if cond.Type != condition {
+				continue
+			}
+
+			lastObserved = fmt.Sprintf("condition %s %s", condition, cond.Status)
+
+			if cond.Status == v1.ConditionTrue {
 				return true, nil
 			}
 		}
 
 		return false, nil
+	}, options...)
+
+	return builder.withTimeoutDetails(err, fmt.Sprintf("condition %s True", condition), lastObserved)
+}
 
+// withTimeoutDetails converts a wait timeout into an await.WaitTimeoutError referencing the pod.
+func (builder *Builder) withTimeoutDetails(err error, wanted, lastObserved string) error {
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          v1.SchemeGroupVersion.WithKind("Pod"),
+		Name:         builder.Definition.Name,
+		Namespace:    builder.Definition.Namespace,
+		Wanted:       wanted,
+		LastObserved: lastObserved,
 	})
 }
 
@@ -540,7 +580,7 @@ func (builder *Builder) WithRestartPolicy(restartPolicy v1.RestartPolicy) *Build
 			"Failed to set RestartPolicy on pod %s in namespace %s. RestartPolicy can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "can not define pod with empty restart policy"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not define pod with empty restart policy")
 	}
 
 	if builder.errorMsg != "" {
@@ -613,13 +653,13 @@ func (builder *Builder) WithLocalVolume(volumeName, mountPath string) *Builder {
 	if volumeName == "" {
 		glog.V(100).Infof("The 'volumeName' of the pod is empty")
 
-		builder.errorMsg = "'volumeName' parameter is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'volumeName' parameter is empty")
 	}
 
 	if mountPath == "" {
 		glog.V(100).Infof("The 'mountPath' of the pod is empty")
 
-		builder.errorMsg = "'mountPath' parameter is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'mountPath' parameter is empty")
 	}
 
 	mountConfig := v1.VolumeMount{Name: volumeName, MountPath: mountPath, ReadOnly: false}
@@ -664,7 +704,7 @@ func (builder *Builder) WithAdditionalContainer(container *v1.Container) *Builde
 	builder.isMutationAllowed("additional container")
 
 	if container == nil {
-		builder.errorMsg = "'container' parameter cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'container' parameter cannot be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -693,7 +733,8 @@ func (builder *Builder) WithSecondaryNetwork(network []*multus.NetworkSelectionE
 	netAnnotation, err := json.Marshal(network)
 
 	if err != nil {
-		builder.errorMsg = fmt.Sprintf("error to unmarshal network annotation due to: %s", err.Error())
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("error to unmarshal network annotation due to: %s", err.Error()))
 	}
 
 	if builder.errorMsg != "" {
@@ -791,7 +832,7 @@ func (builder *Builder) WithSecurityContext(securityContext *v1.PodSecurityConte
 	if securityContext == nil {
 		glog.V(100).Infof("The 'securityContext' of the pod is empty")
 
-		builder.errorMsg = "'securityContext' parameter is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "'securityContext' parameter is empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -866,7 +907,7 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 	builder.isMutationAllowed("Labels")
 
 	if labelKey == "" {
-		builder.errorMsg = "can not apply empty labelKey"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "can not apply empty labelKey")
 	}
 
 	if builder.errorMsg != "" {
@@ -888,15 +929,19 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -956,8 +1001,8 @@ func (builder *Builder) isMutationAllowed(configToMutate string) {
 			"Failed to redefine %s for running pod %s in namespace %s",
 			builder.Definition.Name, configToMutate, builder.Definition.Namespace)
 
-		builder.errorMsg = fmt.Sprintf(
-			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf(
+			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName))
 	}
 }
 
@@ -966,8 +1011,9 @@ func (builder *Builder) isMountAlreadyInUseInPod(newMount v1.VolumeMount) {
 		for index := range builder.Definition.Spec.Containers {
 			if builder.Definition.Spec.Containers[index].VolumeMounts != nil {
 				if isMountInUse(builder.Definition.Spec.Containers[index].VolumeMounts, newMount) {
-					builder.errorMsg = fmt.Sprintf("given mount %v already mounted to pod's container %s",
-						newMount.Name, builder.Definition.Spec.Containers[index].Name)
+					builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, fmt.Sprintf(
+						"given mount %v already mounted to pod's container %s",
+						newMount.Name, builder.Definition.Spec.Containers[index].Name))
 				}
 			}
 		}
@@ -998,13 +1044,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
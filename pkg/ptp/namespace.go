@@ -0,0 +1,15 @@
+package ptp
+
+import "github.com/openshift-kni/eco-goinfra/pkg/clients"
+
+// NamespaceComponent identifies the PTP operator in the namespace overrides of clients.Settings.
+const NamespaceComponent = "ptp"
+
+// DefaultNamespace is the namespace the PTP operator is installed in by default.
+var DefaultNamespace = "openshift-ptp"
+
+// Namespace returns the namespace of the PTP operator, which is the namespace overridden on apiClient for
+// NamespaceComponent if any, or DefaultNamespace otherwise.
+func Namespace(apiClient *clients.Settings) string {
+	return apiClient.Namespace(NamespaceComponent, DefaultNamespace)
+}
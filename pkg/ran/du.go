@@ -0,0 +1,293 @@
+package ran
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/monitoring"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"github.com/openshift-kni/eco-goinfra/pkg/nto"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"github.com/openshift-kni/eco-goinfra/pkg/ptp"
+	"github.com/openshift-kni/eco-goinfra/pkg/sriov"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CheckName identifies a single check of the DU checklist.
+type CheckName string
+
+const (
+	// CheckPerformanceProfile verifies that the tuned profile rendered from the performance profile is applied.
+	CheckPerformanceProfile CheckName = "PerformanceProfileApplied"
+	// CheckSriovVFs verifies that the SR-IOV virtual functions are created.
+	CheckSriovVFs CheckName = "SriovVFsPresent"
+	// CheckPTPLocked verifies that every PTP clock of the node is locked.
+	CheckPTPLocked CheckName = "PTPLocked"
+	// CheckRTKernel verifies that the node booted the realtime kernel.
+	CheckRTKernel CheckName = "RTKernelBooted"
+)
+
+const (
+	// ptpDaemonLabel selects the linuxptp daemon pods.
+	ptpDaemonLabel = "app=linuxptp-daemon"
+	// ptpMetricsScheme, ptpMetricsPort and ptpMetricsPath locate the metrics of the linuxptp daemon, served through
+	// the kube-rbac-proxy container of the daemon pod.
+	ptpMetricsScheme = "https"
+	ptpMetricsPort   = "8443"
+	ptpMetricsPath   = "/metrics"
+	// ptpClockStateMetric reports the state of a PTP clock, 1 meaning locked.
+	ptpClockStateMetric = "openshift_ptp_clock_state"
+	// performanceTunedProfilePrefix is the prefix of the tuned profile rendered from a performance profile.
+	performanceTunedProfilePrefix = "openshift-node-performance-"
+)
+
+// rtKernelRegex matches the realtime marker of a kernel release, e.g. 5.14.0-284.rt14.284.el9_2.x86_64.
+var rtKernelRegex = regexp.MustCompile(`(^|[.+-])rt\d*([.+-]|$)`)
+
+// DUProfile describes the configuration expected on a DU node.
+type DUProfile struct {
+	// PerformanceProfileName is the name of the performance profile applied to the node. If empty, any applied
+	// tuned profile passes the check.
+	PerformanceProfileName string
+	// SkipSriov skips the SR-IOV check, e.g. on nodes without SR-IOV devices. The node state is looked up in the
+	// namespace of the SR-IOV operator returned by sriov.Namespace.
+	SkipSriov bool
+	// SriovInterfaces are the interfaces that must have virtual functions. If empty, at least one interface of
+	// the node must have virtual functions.
+	SriovInterfaces []string
+	// SkipPTP skips the PTP check, e.g. on nodes without PTP configuration. The linuxptp daemon is looked up in the
+	// namespace of the PTP operator returned by ptp.Namespace.
+	SkipPTP bool
+}
+
+// CheckResult is the outcome of a single check of the DU checklist.
+type CheckResult struct {
+	Name    CheckName
+	Passed  bool
+	Skipped bool
+	Message string
+}
+
+// DUChecklist is the outcome of all checks run against a DU node.
+type DUChecklist struct {
+	NodeName string
+	Results  []CheckResult
+}
+
+// Passed returns true if no check of the checklist failed. Skipped checks do not fail the checklist.
+func (checklist *DUChecklist) Passed() bool {
+	return len(checklist.Failed()) == 0
+}
+
+// Failed returns the checks of the checklist that failed.
+func (checklist *DUChecklist) Failed() []CheckResult {
+	var failed []CheckResult
+
+	for _, result := range checklist.Results {
+		if !result.Passed && !result.Skipped {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// String returns a one line summary of the checklist.
+func (checklist *DUChecklist) String() string {
+	results := make([]string, 0, len(checklist.Results))
+
+	for _, result := range checklist.Results {
+		outcome := "failed"
+
+		switch {
+		case result.Skipped:
+			outcome = "skipped"
+		case result.Passed:
+			outcome = "passed"
+		}
+
+		results = append(results, fmt.Sprintf("%s %s: %s", result.Name, outcome, result.Message))
+	}
+
+	return fmt.Sprintf("node %s: %s", checklist.NodeName, strings.Join(results, "; "))
+}
+
+// ValidateDUNode runs the DU acceptance checks against the given node and returns their outcome. The returned error
+// is only set if the checks could not be run at all, a failing check is reported in the checklist.
+func ValidateDUNode(apiClient *clients.Settings, nodeName string, profile DUProfile) (*DUChecklist, error) {
+	glog.V(100).Infof("Validating DU configuration of node %s", nodeName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to validate DU node, 'apiClient' parameter is nil")
+	}
+
+	if nodeName == "" {
+		glog.V(100).Infof("The node name is empty")
+
+		return nil, fmt.Errorf("failed to validate DU node, 'nodeName' parameter is empty")
+	}
+
+	return &DUChecklist{
+		NodeName: nodeName,
+		Results: []CheckResult{
+			checkPerformanceProfile(apiClient, nodeName, profile.PerformanceProfileName),
+			checkSriovVFs(apiClient, nodeName, profile.SkipSriov, profile.SriovInterfaces),
+			checkPTPLocked(apiClient, nodeName, profile.SkipPTP),
+			checkRTKernel(apiClient, nodeName),
+		},
+	}, nil
+}
+
+// checkPerformanceProfile checks that the tuned profile of the node is applied and not degraded.
+func checkPerformanceProfile(apiClient *clients.Settings, nodeName, performanceProfileName string) CheckResult {
+	result := CheckResult{Name: CheckPerformanceProfile}
+
+	status, err := nto.GetTunedProfileStatus(apiClient, nodeName)
+	if err != nil {
+		result.Message = err.Error()
+
+		return result
+	}
+
+	if performanceProfileName != "" && status.TunedProfile != performanceTunedProfilePrefix+performanceProfileName {
+		result.Message = fmt.Sprintf("tuned profile %q is not rendered from performance profile %s",
+			status.TunedProfile, performanceProfileName)
+
+		return result
+	}
+
+	result.Passed = status.Applied && !status.Degraded
+	result.Message = fmt.Sprintf("tuned profile %q applied %t, degraded %t", status.TunedProfile, status.Applied,
+		status.Degraded)
+
+	return result
+}
+
+// checkSriovVFs checks that the expected interfaces of the node have virtual functions.
+func checkSriovVFs(apiClient *clients.Settings, nodeName string, skip bool, interfaces []string) CheckResult {
+	result := CheckResult{Name: CheckSriovVFs}
+
+	if skip {
+		result.Skipped = true
+		result.Message = "SR-IOV check skipped"
+
+		return result
+	}
+
+	nics, err := sriov.NewNetworkNodeStateBuilder(apiClient, nodeName, sriov.Namespace(apiClient)).GetNICs()
+	if err != nil {
+		result.Message = err.Error()
+
+		return result
+	}
+
+	vfCounts := make(map[string]int)
+
+	for _, nic := range nics {
+		if len(nic.VFs) > 0 {
+			vfCounts[nic.Name] = len(nic.VFs)
+		}
+	}
+
+	if len(interfaces) == 0 {
+		result.Passed = len(vfCounts) > 0
+		result.Message = fmt.Sprintf("interfaces with virtual functions: %v", vfCounts)
+
+		return result
+	}
+
+	var missing []string
+
+	for _, nicName := range interfaces {
+		if vfCounts[nicName] == 0 {
+			missing = append(missing, nicName)
+		}
+	}
+
+	result.Passed = len(missing) == 0
+	result.Message = fmt.Sprintf("interfaces without virtual functions: %v", missing)
+
+	return result
+}
+
+// checkPTPLocked checks that the linuxptp daemon of the node reports all of its clocks as locked.
+func checkPTPLocked(apiClient *clients.Settings, nodeName string, skip bool) CheckResult {
+	result := CheckResult{Name: CheckPTPLocked}
+
+	if skip {
+		result.Skipped = true
+		result.Message = "PTP check skipped"
+
+		return result
+	}
+
+	nsname := ptp.Namespace(apiClient)
+
+	daemonPods, err := pod.List(apiClient, nsname, metaV1.ListOptions{
+		LabelSelector: ptpDaemonLabel,
+		FieldSelector: fmt.Sprintf("spec.nodeName=%s", nodeName),
+	})
+	if err != nil {
+		result.Message = err.Error()
+
+		return result
+	}
+
+	if len(daemonPods) == 0 {
+		result.Message = fmt.Sprintf("no linuxptp daemon pod found in namespace %s", nsname)
+
+		return result
+	}
+
+	samples, err := monitoring.ScrapePod(
+		apiClient, nsname, ptpMetricsScheme, daemonPods[0].Object.Name, ptpMetricsPort, ptpMetricsPath)
+	if err != nil {
+		result.Message = fmt.Sprintf("failed to read ptp metrics: %s", err.Error())
+
+		return result
+	}
+
+	clockStates := monitoring.FilterSamples(samples, ptpClockStateMetric)
+
+	var unlocked []map[string]string
+
+	for _, clockState := range clockStates {
+		if clockState.Value != 1 {
+			unlocked = append(unlocked, clockState.Labels)
+		}
+	}
+
+	if len(clockStates) == 0 {
+		result.Message = "no ptp clock state reported"
+
+		return result
+	}
+
+	result.Passed = len(unlocked) == 0
+	result.Message = fmt.Sprintf("%d clocks reported, not locked: %v", len(clockStates), unlocked)
+
+	return result
+}
+
+// checkRTKernel checks that the kernel release reported by the node is a realtime kernel.
+func checkRTKernel(apiClient *clients.Settings, nodeName string) CheckResult {
+	result := CheckResult{Name: CheckRTKernel}
+
+	node, err := nodes.PullNode(apiClient, nodeName)
+	if err != nil {
+		result.Message = err.Error()
+
+		return result
+	}
+
+	kernelVersion := node.Object.Status.NodeInfo.KernelVersion
+	result.Passed = rtKernelRegex.MatchString(kernelVersion)
+	result.Message = fmt.Sprintf("kernel %s", kernelVersion)
+
+	return result
+}
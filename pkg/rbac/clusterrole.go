@@ -51,7 +51,7 @@ func NewClusterRoleBuilder(apiClient *clients.Settings, name string, rule v1.Pol
 	if name == "" {
 		glog.V(100).Infof("The name of the clusterrole is empty")
 
-		builder.errorMsg = "clusterrole 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterrole 'name' cannot be empty")
 	}
 
 	builder.WithRules([]v1.PolicyRule{rule})
@@ -71,7 +71,7 @@ func (builder *ClusterRoleBuilder) WithRules(rules []v1.PolicyRule) *ClusterRole
 	if len(rules) == 0 {
 		glog.V(100).Infof("The list of rules is empty")
 
-		builder.errorMsg = "cannot accept nil or empty slice as rules"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot accept nil or empty slice as rules")
 	}
 
 	if builder.errorMsg != "" {
@@ -82,19 +82,19 @@ func (builder *ClusterRoleBuilder) WithRules(rules []v1.PolicyRule) *ClusterRole
 		if len(rule.APIGroups) == 0 {
 			glog.V(100).Infof("The clusterrole rule must contain at least one APIGroup entry")
 
-			builder.errorMsg = "clusterrole rule must contain at least one APIGroup entry"
+			builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterrole rule must contain at least one APIGroup entry")
 		}
 
 		if len(rule.Verbs) == 0 {
 			glog.V(100).Infof("The clusterrole rule must contain at least one Verb entry")
 
-			builder.errorMsg = "clusterrole rule must contain at least one Verb entry"
+			builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterrole rule must contain at least one Verb entry")
 		}
 
 		if len(rule.Resources) == 0 {
 			glog.V(100).Infof("The clusterrole rule must contain at least one Resource entry")
 
-			builder.errorMsg = "clusterrole rule must contain at least one Resource entry"
+			builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterrole rule must contain at least one Resource entry")
 		}
 
 		if builder.errorMsg != "" {
@@ -123,15 +123,19 @@ func (builder *ClusterRoleBuilder) WithOptions(options ...ClusterRoleAdditionalO
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -154,7 +158,7 @@ func PullClusterRole(apiClient *clients.Settings, name string) (*ClusterRoleBuil
 	if name == "" {
 		glog.V(100).Infof("The name of the clusterrole is empty")
 
-		builder.errorMsg = "clusterrole 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterrole 'name' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -179,6 +183,9 @@ func (builder *ClusterRoleBuilder) Create() (*ClusterRoleBuilder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.ClusterRoles().Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -204,6 +211,8 @@ func (builder *ClusterRoleBuilder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -221,8 +230,13 @@ func (builder *ClusterRoleBuilder) Update() (*ClusterRoleBuilder, error) {
 	var err error
 	builder.Object, err = builder.apiClient.ClusterRoles().Update(
 		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // Exists checks if a clusterrole exists in the cluster.
@@ -255,13 +269,14 @@ func (builder *ClusterRoleBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -56,7 +56,7 @@ func NewClusterRoleBindingBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the clusterrolebinding is empty")
 
-		builder.errorMsg = "clusterrolebinding 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterrolebinding 'name' cannot be empty")
 	}
 
 	return &builder
@@ -74,7 +74,7 @@ func (builder *ClusterRoleBindingBuilder) WithSubjects(subjects []v1.Subject) *C
 	if len(subjects) == 0 {
 		glog.V(100).Infof("The list of subjects is empty")
 
-		builder.errorMsg = "cannot accept nil or empty slice as subjects"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot accept nil or empty slice as subjects")
 	}
 
 	if builder.errorMsg != "" {
@@ -85,13 +85,14 @@ func (builder *ClusterRoleBindingBuilder) WithSubjects(subjects []v1.Subject) *C
 		if !slices.Contains(allowedSubjectKinds(), subject.Kind) {
 			glog.V(100).Infof("The clusterrolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
 
-			builder.errorMsg = "clusterrolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'"
+			builder.errorMsg = msg.AppendErrorMsg(
+				builder.errorMsg, "clusterrolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
 		}
 
 		if subject.Name == "" {
 			glog.V(100).Infof("The clusterrolebinding subject name cannot be empty")
 
-			builder.errorMsg = "clusterrolebinding subject name cannot be empty"
+			builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterrolebinding subject name cannot be empty")
 		}
 
 		if builder.errorMsg != "" {
@@ -115,15 +116,19 @@ func (builder *ClusterRoleBindingBuilder) WithOptions(
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -146,7 +151,7 @@ func PullClusterRoleBinding(apiClient *clients.Settings, name string) (*ClusterR
 	if name == "" {
 		glog.V(100).Infof("The name of the clusterrolebinding is empty")
 
-		builder.errorMsg = "clusterrolebinding 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "clusterrolebinding 'name' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -171,6 +176,9 @@ func (builder *ClusterRoleBindingBuilder) Create() (*ClusterRoleBindingBuilder,
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.ClusterRoleBindings().Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -196,6 +204,8 @@ func (builder *ClusterRoleBindingBuilder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -213,8 +223,13 @@ func (builder *ClusterRoleBindingBuilder) Update() (*ClusterRoleBindingBuilder,
 	var err error
 	builder.Object, err = builder.apiClient.ClusterRoleBindings().Update(
 		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // Exists checks if clusterrolebinding exists in the cluster.
@@ -247,13 +262,14 @@ func (builder *ClusterRoleBindingBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
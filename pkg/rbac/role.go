@@ -47,13 +47,13 @@ func NewRoleBuilder(apiClient *clients.Settings, name, nsname string, rule v1.Po
 	if name == "" {
 		glog.V(100).Infof("The name of the role is empty")
 
-		builder.errorMsg = "Role 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Role 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the role is empty")
 
-		builder.errorMsg = "Role 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Role 'nsname' cannot be empty")
 	}
 
 	builder.WithRules([]v1.PolicyRule{rule})
@@ -73,7 +73,7 @@ func (builder *RoleBuilder) WithRules(rules []v1.PolicyRule) *RoleBuilder {
 	if len(rules) == 0 {
 		glog.V(100).Infof("The list of rules is empty")
 
-		builder.errorMsg = "cannot create role with empty rule"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot create role with empty rule")
 	}
 
 	if builder.errorMsg != "" {
@@ -84,19 +84,19 @@ func (builder *RoleBuilder) WithRules(rules []v1.PolicyRule) *RoleBuilder {
 		if len(rule.Verbs) == 0 {
 			glog.V(100).Infof("The role has no verbs")
 
-			builder.errorMsg = "role must contain at least one Verb"
+			builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "role must contain at least one Verb")
 		}
 
 		if len(rule.Resources) == 0 {
 			glog.V(100).Infof("The role has no resources")
 
-			builder.errorMsg = "role must contain at least one Resource"
+			builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "role must contain at least one Resource")
 		}
 
 		if len(rule.APIGroups) == 0 {
 			glog.V(100).Infof("The role has no apigroups")
 
-			builder.errorMsg = "role must contain at least one APIGroup"
+			builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "role must contain at least one APIGroup")
 		}
 
 		if builder.errorMsg != "" {
@@ -124,15 +124,19 @@ func (builder *RoleBuilder) WithOptions(
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -156,13 +160,13 @@ func PullRole(apiClient *clients.Settings, name, nsname string) (*RoleBuilder, e
 	if name == "" {
 		glog.V(100).Infof("The name of the role is empty")
 
-		builder.errorMsg = "role 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "role 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the role is empty")
 
-		builder.errorMsg = "role 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "role 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -187,6 +191,9 @@ func (builder *RoleBuilder) Create() (*RoleBuilder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Roles(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -212,6 +219,8 @@ func (builder *RoleBuilder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -229,8 +238,13 @@ func (builder *RoleBuilder) Update() (*RoleBuilder, error) {
 	var err error
 	builder.Object, err = builder.apiClient.Roles(builder.Definition.Namespace).Update(
 		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // Exists checks whether the given Role exists.
@@ -263,13 +277,14 @@ func (builder *RoleBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -56,13 +56,13 @@ func NewRoleBindingBuilder(apiClient *clients.Settings,
 	if name == "" {
 		glog.V(100).Infof("The name of the rolebinding is empty")
 
-		builder.errorMsg = "RoleBinding 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "RoleBinding 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the rolebinding is empty")
 
-		builder.errorMsg = "RoleBinding 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "RoleBinding 'nsname' cannot be empty")
 	}
 
 	builder.WithSubjects([]v1.Subject{subject})
@@ -82,7 +82,7 @@ func (builder *RoleBindingBuilder) WithSubjects(subjects []v1.Subject) *RoleBind
 	if len(subjects) == 0 {
 		glog.V(100).Infof("The list of subjects is empty")
 
-		builder.errorMsg = "cannot create rolebinding with empty subject"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot create rolebinding with empty subject")
 	}
 
 	if builder.errorMsg != "" {
@@ -93,13 +93,14 @@ func (builder *RoleBindingBuilder) WithSubjects(subjects []v1.Subject) *RoleBind
 		if !slices.Contains(allowedSubjectKinds(), subject.Kind) {
 			glog.V(100).Infof("The rolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
 
-			builder.errorMsg = "rolebinding subject kind must be one of 'ServiceAccount', 'User', 'Group'"
+			builder.errorMsg = msg.AppendErrorMsg(
+				builder.errorMsg, "rolebinding subject kind must be one of 'ServiceAccount', 'User', 'Group'")
 		}
 
 		if subject.Name == "" {
 			glog.V(100).Infof("The rolebinding subject name cannot be empty")
 
-			builder.errorMsg = "rolebinding subject name cannot be empty"
+			builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "rolebinding subject name cannot be empty")
 		}
 
 		if builder.errorMsg != "" {
@@ -121,15 +122,19 @@ func (builder *RoleBindingBuilder) WithOptions(options ...RoleBindingAdditionalO
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -153,13 +158,13 @@ func PullRoleBinding(apiClient *clients.Settings, name, nsname string) (*RoleBin
 	if name == "" {
 		glog.V(100).Infof("The name of the rolebinding is empty")
 
-		builder.errorMsg = "rolebinding 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "rolebinding 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the rolebinding is empty")
 
-		builder.errorMsg = "rolebinding 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "rolebinding 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -184,6 +189,9 @@ func (builder *RoleBindingBuilder) Create() (*RoleBindingBuilder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.RoleBindings(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -204,10 +212,15 @@ func (builder *RoleBindingBuilder) Delete() error {
 
 	err := builder.apiClient.RoleBindings(builder.Definition.Namespace).Delete(
 		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
 
 	builder.Object = nil
 
-	return err
+	return nil
 }
 
 // Update modifies an existing RoleBinding in the cluster.
@@ -222,8 +235,13 @@ func (builder *RoleBindingBuilder) Update() (*RoleBindingBuilder, error) {
 	var err error
 	builder.Object, err = builder.apiClient.RoleBindings(builder.Definition.Namespace).Update(
 		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // Exists checks whether the given RoleBinding exists.
@@ -256,13 +274,14 @@ func (builder *RoleBindingBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -0,0 +1,137 @@
+package reporter
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+)
+
+// JSONStepSink is a clients.StepReporter writing every step as a single line of JSON as soon as it is reported.
+type JSONStepSink struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONStepSink creates a new instance of JSONStepSink writing to writer.
+func NewJSONStepSink(writer io.Writer) (*JSONStepSink, error) {
+	if writer == nil {
+		return nil, fmt.Errorf("failed to create JSON step sink, 'writer' parameter is nil")
+	}
+
+	return &JSONStepSink{encoder: json.NewEncoder(writer)}, nil
+}
+
+// ReportStep writes the step as a line of JSON. Write errors are ignored so that reporting never fails a test.
+func (sink *JSONStepSink) ReportStep(record clients.StepRecord) {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+
+	_ = sink.encoder.Encode(record)
+}
+
+// JUnitStepSink is a clients.StepReporter collecting the steps to write them as a JUnit test suite, with one test
+// case per step, so that CI systems render a timeline of the operations performed on each resource.
+type JUnitStepSink struct {
+	mutex   sync.Mutex
+	name    string
+	records []clients.StepRecord
+}
+
+// NewJUnitStepSink creates a new instance of JUnitStepSink writing a test suite with the given name.
+func NewJUnitStepSink(suiteName string) *JUnitStepSink {
+	return &JUnitStepSink{name: suiteName}
+}
+
+// ReportStep collects the step.
+func (sink *JUnitStepSink) ReportStep(record clients.StepRecord) {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+
+	sink.records = append(sink.records, record)
+}
+
+// Records returns a copy of the steps collected so far.
+func (sink *JUnitStepSink) Records() []clients.StepRecord {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+
+	return append([]clients.StepRecord{}, sink.records...)
+}
+
+// junitTestSuite is the JUnit XML test suite written by JUnitStepSink.
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Time      float64         `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase is a single step in the JUnit XML test suite.
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      float64       `xml:"time,attr"`
+	Timestamp string        `xml:"timestamp,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+// junitFailure is the failure of a step in the JUnit XML test suite.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnit writes the collected steps to writer as a JUnit XML test suite, in the order they were reported.
+func (sink *JUnitStepSink) WriteJUnit(writer io.Writer) error {
+	if writer == nil {
+		return fmt.Errorf("failed to write JUnit steps, 'writer' parameter is nil")
+	}
+
+	suite := junitTestSuite{Name: sink.name}
+
+	for _, record := range sink.Records() {
+		object := record.Name
+		if record.Namespace != "" {
+			object = fmt.Sprintf("%s/%s", record.Namespace, record.Name)
+		}
+
+		testCase := junitTestCase{
+			Name:      fmt.Sprintf("%s %s %s", record.Action, record.Kind, object),
+			ClassName: record.Kind,
+			Time:      record.Duration.Seconds(),
+			Timestamp: record.Start.UTC().Format("2006-01-02T15:04:05"),
+		}
+
+		if record.Outcome == clients.StepFailed {
+			testCase.Failure = &junitFailure{Message: record.Message}
+			suite.Failures++
+		}
+
+		suite.Time += testCase.Time
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	suite.Tests = len(suite.TestCases)
+
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return fmt.Errorf("failed to write JUnit steps: %w", err)
+	}
+
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(suite); err != nil {
+		return fmt.Errorf("failed to write JUnit steps: %w", err)
+	}
+
+	if _, err := io.WriteString(writer, "\n"); err != nil {
+		return fmt.Errorf("failed to write JUnit steps: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package resourceusage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	podMetricsGVR  = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+	nodeMetricsGVR = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "nodes"}
+)
+
+// Sample is a single CPU and memory usage measurement.
+type Sample struct {
+	Timestamp     time.Time
+	CPUMillicores int64
+	MemoryBytes   int64
+}
+
+// Series contains the samples recorded per object. Pods are keyed as pod/<namespace>/<name> and nodes as
+// node/<name>.
+type Series map[string][]Sample
+
+// Max returns the highest CPU and memory usage recorded for the given key.
+func (series Series) Max(key string) (cpuMillicores, memoryBytes int64) {
+	for _, sample := range series[key] {
+		if sample.CPUMillicores > cpuMillicores {
+			cpuMillicores = sample.CPUMillicores
+		}
+
+		if sample.MemoryBytes > memoryBytes {
+			memoryBytes = sample.MemoryBytes
+		}
+	}
+
+	return cpuMillicores, memoryBytes
+}
+
+// Sampler periodically records the CPU and memory usage of the selected pods and nodes from the metrics API.
+type Sampler struct {
+	apiClient     *clients.Settings
+	interval      time.Duration
+	podNamespace  string
+	podSelector   string
+	nodeSelector  string
+	sampleNodes   bool
+	samplePods    bool
+	series        Series
+	mutex         sync.Mutex
+	stop          chan struct{}
+	done          chan struct{}
+	lastSampleErr error
+}
+
+// NewSampler creates a new instance of Sampler recording usage every interval.
+func NewSampler(apiClient *clients.Settings, interval time.Duration) (*Sampler, error) {
+	glog.V(100).Infof("Initializing new resource usage sampler with interval %s", interval)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to initialize sampler, 'apiClient' parameter is nil")
+	}
+
+	if interval <= 0 {
+		glog.V(100).Infof("The sampling interval is not positive")
+
+		return nil, fmt.Errorf("failed to initialize sampler, 'interval' must be greater than 0")
+	}
+
+	return &Sampler{apiClient: apiClient, interval: interval, series: make(Series)}, nil
+}
+
+// WithPods adds the pods of the given namespace matching labelSelector to the sampled objects.
+func (sampler *Sampler) WithPods(nsname, labelSelector string) *Sampler {
+	sampler.samplePods = true
+	sampler.podNamespace = nsname
+	sampler.podSelector = labelSelector
+
+	return sampler
+}
+
+// WithNodes adds the nodes matching labelSelector to the sampled objects.
+func (sampler *Sampler) WithNodes(labelSelector string) *Sampler {
+	sampler.sampleNodes = true
+	sampler.nodeSelector = labelSelector
+
+	return sampler
+}
+
+// Start takes a first sample and keeps sampling in the background until Stop is called.
+func (sampler *Sampler) Start() error {
+	if sampler == nil {
+		return fmt.Errorf("error: received nil sampler")
+	}
+
+	if !sampler.samplePods && !sampler.sampleNodes {
+		return fmt.Errorf("sampler has neither pods nor nodes to sample")
+	}
+
+	if sampler.stop != nil {
+		return fmt.Errorf("sampler is already running")
+	}
+
+	if err := sampler.sample(); err != nil {
+		return err
+	}
+
+	sampler.stop = make(chan struct{})
+	sampler.done = make(chan struct{})
+
+	go func() {
+		defer close(sampler.done)
+
+		ticker := time.NewTicker(sampler.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-sampler.stop:
+				return
+			case <-ticker.C:
+				if err := sampler.sample(); err != nil {
+					glog.V(100).Infof("Failed to sample resource usage: %s", err.Error())
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop ends the sampling and returns the recorded series together with the last sampling error, if any.
+func (sampler *Sampler) Stop() (Series, error) {
+	if sampler == nil {
+		return nil, fmt.Errorf("error: received nil sampler")
+	}
+
+	if sampler.stop != nil {
+		close(sampler.stop)
+		<-sampler.done
+
+		sampler.stop = nil
+	}
+
+	sampler.mutex.Lock()
+	defer sampler.mutex.Unlock()
+
+	return sampler.series, sampler.lastSampleErr
+}
+
+// sample records a single measurement of all selected objects.
+func (sampler *Sampler) sample() error {
+	var samples = make(map[string]Sample)
+
+	if sampler.samplePods {
+		podMetrics, err := sampler.apiClient.Resource(podMetricsGVR).Namespace(sampler.podNamespace).List(
+			context.TODO(), metaV1.ListOptions{LabelSelector: sampler.podSelector})
+		if err != nil {
+			return sampler.recordError(fmt.Errorf("failed to get pod metrics: %w", err))
+		}
+
+		for _, podMetric := range podMetrics.Items {
+			containers, _, _ := unstructured.NestedSlice(podMetric.Object, "containers")
+
+			var podSample Sample
+
+			for _, container := range containers {
+				containerMap, ok := container.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				usage, _, _ := unstructured.NestedStringMap(containerMap, "usage")
+				containerSample := parseUsage(usage)
+				podSample.CPUMillicores += containerSample.CPUMillicores
+				podSample.MemoryBytes += containerSample.MemoryBytes
+			}
+
+			podSample.Timestamp = metricTimestamp(podMetric)
+			samples[fmt.Sprintf("pod/%s/%s", podMetric.GetNamespace(), podMetric.GetName())] = podSample
+		}
+	}
+
+	if sampler.sampleNodes {
+		nodeMetrics, err := sampler.apiClient.Resource(nodeMetricsGVR).List(
+			context.TODO(), metaV1.ListOptions{LabelSelector: sampler.nodeSelector})
+		if err != nil {
+			return sampler.recordError(fmt.Errorf("failed to get node metrics: %w", err))
+		}
+
+		for _, nodeMetric := range nodeMetrics.Items {
+			usage, _, _ := unstructured.NestedStringMap(nodeMetric.Object, "usage")
+			nodeSample := parseUsage(usage)
+			nodeSample.Timestamp = metricTimestamp(nodeMetric)
+			samples[fmt.Sprintf("node/%s", nodeMetric.GetName())] = nodeSample
+		}
+	}
+
+	sampler.mutex.Lock()
+	defer sampler.mutex.Unlock()
+
+	for key, sample := range samples {
+		sampler.series[key] = append(sampler.series[key], sample)
+	}
+
+	return nil
+}
+
+// recordError stores err as the last sampling error and returns it.
+func (sampler *Sampler) recordError(err error) error {
+	sampler.mutex.Lock()
+	defer sampler.mutex.Unlock()
+
+	sampler.lastSampleErr = err
+
+	return err
+}
+
+// parseUsage converts a metrics API usage map into a Sample.
+func parseUsage(usage map[string]string) Sample {
+	var sample Sample
+
+	if cpu, err := resource.ParseQuantity(usage["cpu"]); err == nil {
+		sample.CPUMillicores = cpu.MilliValue()
+	}
+
+	if memory, err := resource.ParseQuantity(usage["memory"]); err == nil {
+		sample.MemoryBytes = memory.Value()
+	}
+
+	return sample
+}
+
+// metricTimestamp returns the time the metric was collected at, or now if it is not reported.
+func metricTimestamp(metric unstructured.Unstructured) time.Time {
+	timestamp, _, _ := unstructured.NestedString(metric.Object, "timestamp")
+
+	parsedTime, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return time.Now()
+	}
+
+	return parsedTime
+}
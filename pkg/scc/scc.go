@@ -53,19 +53,19 @@ func NewBuilder(apiClient *clients.Settings, name, runAsUser, selinuxContext str
 	if name == "" {
 		glog.V(100).Infof("The name of the SecurityContextConstraints is empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SecurityContextConstraints 'name' cannot be empty")
 	}
 
 	if runAsUser == "" {
 		glog.V(100).Infof("The runAsUser of the SecurityContextConstraints is empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'runAsUser' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SecurityContextConstraints 'runAsUser' cannot be empty")
 	}
 
 	if selinuxContext == "" {
 		glog.V(100).Infof("The selinuxContext of the SecurityContextConstraints is empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'selinuxContext' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SecurityContextConstraints 'selinuxContext' cannot be empty")
 	}
 
 	return &builder
@@ -87,7 +87,7 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	if name == "" {
 		glog.V(100).Infof("The name of the SecurityContextConstraints is empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SecurityContextConstraints 'name' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -139,7 +139,8 @@ func (builder *Builder) WithDropCapabilities(requiredDropCapabilities []coreV1.C
 	if len(requiredDropCapabilities) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'requiredDropCapabilities' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'requiredDropCapabilities' cannot be empty list"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "SecurityContextConstraints 'requiredDropCapabilities' cannot be empty list")
 
 		return builder
 	}
@@ -168,7 +169,8 @@ func (builder *Builder) WithAllowCapabilities(allowCapabilities []coreV1.Capabil
 	if len(allowCapabilities) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'allowCapabilities' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'allowCapabilities' cannot be empty list"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "SecurityContextConstraints 'allowCapabilities' cannot be empty list")
 
 		return builder
 	}
@@ -196,7 +198,7 @@ func (builder *Builder) WithFSGroup(fsGroup string) *Builder {
 	if fsGroup == "" {
 		glog.V(100).Infof("SecurityContextConstraints 'fsGroup' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'fsGroup' cannot be empty string"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SecurityContextConstraints 'fsGroup' cannot be empty string")
 
 		return builder
 	}
@@ -218,7 +220,8 @@ func (builder *Builder) WithSeccompProfiles(seccompProfiles []string) *Builder {
 	if len(seccompProfiles) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'seccompProfiles' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'seccompProfiles' cannot be empty list"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "SecurityContextConstraints 'seccompProfiles' cannot be empty list")
 
 		return builder
 	}
@@ -246,7 +249,8 @@ func (builder *Builder) WithSupplementalGroups(supplementalGroupsType string) *B
 	if supplementalGroupsType == "" {
 		glog.V(100).Infof("SecurityContextConstraints 'SupplementalGroups' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'SupplementalGroups' cannot be empty string"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "SecurityContextConstraints 'SupplementalGroups' cannot be empty string")
 
 		return builder
 	}
@@ -268,7 +272,7 @@ func (builder *Builder) WithUsers(users []string) *Builder {
 	if len(users) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'users' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'users' cannot be empty list"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SecurityContextConstraints 'users' cannot be empty list")
 
 		return builder
 	}
@@ -296,6 +300,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.SecurityContextConstraints().Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -315,10 +322,15 @@ func (builder *Builder) Delete() error {
 
 	err := builder.apiClient.SecurityContextConstraints().Delete(
 		context.TODO(), builder.Object.Name, metaV1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
 
 	builder.Object = nil
 
-	return err
+	return nil
 }
 
 // Update modifies an existing SecurityContextConstraints in the cluster.
@@ -332,8 +344,13 @@ func (builder *Builder) Update() (*Builder, error) {
 	var err error
 	builder.Object, err = builder.apiClient.SecurityContextConstraints().Update(
 		context.TODO(), builder.Definition, metaV1.UpdateOptions{})
+	if err != nil {
+		return builder, err
+	}
 
-	return builder, err
+	builder.apiClient.RunHooks(clients.HookEventUpdate, builder.Object)
+
+	return builder, nil
 }
 
 // Exists checks whether the given SecurityContextConstraints exists.
@@ -365,13 +382,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
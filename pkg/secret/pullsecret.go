@@ -0,0 +1,44 @@
+package secret
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// GlobalPullSecretName is the name of the cluster-wide pull secret.
+	GlobalPullSecretName = "pull-secret"
+	// GlobalPullSecretNamespace is the namespace of the cluster-wide pull secret.
+	GlobalPullSecretNamespace = "openshift-config"
+)
+
+// NewBuilderFromGlobalPullSecret creates a new instance of Builder holding a copy of the cluster-wide pull secret
+// with the given name and namespace. The returned builder is not created on the cluster.
+func NewBuilderFromGlobalPullSecret(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	glog.V(100).Infof("Initializing secret %s in namespace %s from the global pull secret", name, nsname)
+
+	globalPullSecret, err := Pull(apiClient, GlobalPullSecretName, GlobalPullSecretNamespace)
+	if err != nil {
+		glog.V(100).Infof("Failed to pull the global pull secret due to %s", err.Error())
+
+		return nil, err
+	}
+
+	dockerConfig, ok := globalPullSecret.Object.Data[v1.DockerConfigJsonKey]
+	if !ok || len(dockerConfig) == 0 {
+		return nil, fmt.Errorf("global pull secret %s in namespace %s has no %s key",
+			GlobalPullSecretName, GlobalPullSecretNamespace, v1.DockerConfigJsonKey)
+	}
+
+	builder := NewBuilder(apiClient, name, nsname, v1.SecretTypeDockerConfigJson).
+		WithData(map[string][]byte{v1.DockerConfigJsonKey: dockerConfig})
+
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return builder, nil
+}
@@ -0,0 +1,65 @@
+package serializer
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// FromYAML decodes the YAML manifest in data into object. Unknown fields are rejected and the apiVersion and kind
+// of the manifest must match gvk, so that a manifest of another resource is not silently loaded.
+func FromYAML(data []byte, object runtime.Object, gvk schema.GroupVersionKind) error {
+	glog.V(100).Infof("Decoding %s from YAML", gvk.Kind)
+
+	if object == nil {
+		glog.V(100).Infof("The object to decode into is nil")
+
+		return fmt.Errorf("cannot decode YAML into nil object")
+	}
+
+	if len(data) == 0 {
+		glog.V(100).Infof("The YAML manifest is empty")
+
+		return fmt.Errorf("cannot decode empty YAML manifest")
+	}
+
+	if err := yaml.UnmarshalStrict(data, object); err != nil {
+		return fmt.Errorf("failed to decode %s from YAML: %w", gvk.Kind, err)
+	}
+
+	if manifestGVK := object.GetObjectKind().GroupVersionKind(); manifestGVK != gvk {
+		return fmt.Errorf("YAML manifest is a %s, expected %s", manifestGVK.String(), gvk.String())
+	}
+
+	return nil
+}
+
+// ToYAML encodes a copy of object as a YAML manifest with the apiVersion and kind of gvk. The managed fields are
+// dropped from the copy since they only add noise when debugging or diffing objects.
+func ToYAML(object runtime.Object, gvk schema.GroupVersionKind) ([]byte, error) {
+	glog.V(100).Infof("Encoding %s as YAML", gvk.Kind)
+
+	if object == nil {
+		glog.V(100).Infof("The object to encode is nil")
+
+		return nil, fmt.Errorf("cannot encode nil object as YAML")
+	}
+
+	objectCopy := object.DeepCopyObject()
+	objectCopy.GetObjectKind().SetGroupVersionKind(gvk)
+
+	if accessor, err := meta.Accessor(objectCopy); err == nil {
+		accessor.SetManagedFields(nil)
+	}
+
+	data, err := yaml.Marshal(objectCopy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s as YAML: %w", gvk.Kind, err)
+	}
+
+	return data, nil
+}
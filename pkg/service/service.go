@@ -59,13 +59,13 @@ func NewBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the service is empty")
 
-		builder.errorMsg = "Service 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Service 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the service is empty")
 
-		builder.errorMsg = "Namespace 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Namespace 'nsname' cannot be empty")
 	}
 
 	return &builder
@@ -80,7 +80,7 @@ func (builder *Builder) WithNodePort() *Builder {
 	builder.Definition.Spec.Type = "NodePort"
 
 	if len(builder.Definition.Spec.Ports) < 1 {
-		builder.errorMsg = "service does not have the available ports"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "service does not have the available ports")
 
 		return builder
 	}
@@ -105,11 +105,11 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		builder.errorMsg = "service 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "service 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "service 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "service 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -133,6 +133,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.Services(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -174,6 +177,8 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -189,15 +194,19 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -219,7 +228,7 @@ func (builder *Builder) WithExternalTrafficPolicy(policyType v1.ServiceExternalT
 				"policyType can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "ExternalTrafficPolicy can not be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "ExternalTrafficPolicy can not be empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -246,7 +255,7 @@ func (builder *Builder) WithAnnotation(annotation map[string]string) *Builder {
 				"Service Annotation can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "Annotation can not be empty map"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "Annotation can not be empty map")
 	}
 
 	if builder.errorMsg != "" {
@@ -270,14 +279,14 @@ func (builder *Builder) WithIPFamily(ipFamily []v1.IPFamily, ipStackPolicy v1.IP
 		glog.V(100).Infof("Failed to set empty ipFamily on service %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "failed to set empty ipFamily"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "failed to set empty ipFamily")
 	}
 
 	if ipStackPolicy == "" {
 		glog.V(100).Infof("Failed to set empty ipStackPolicy on service %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "failed to set empty ipStackPolicy"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "failed to set empty ipStackPolicy")
 	}
 
 	if builder.errorMsg != "" {
@@ -343,13 +352,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
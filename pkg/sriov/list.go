@@ -14,6 +14,12 @@ func ListNetworkNodeState(
 	apiClient *clients.Settings, nsname string, options metaV1.ListOptions) ([]*NetworkNodeStateBuilder, error) {
 	glog.V(100).Infof("Listing SriovNetworkNodeStates in the namespace %s with the options %v", nsname, options)
 
+	if apiClient == nil {
+		glog.V(100).Infof("SriovNetworkNodeStates 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list SriovNetworkNodeStates, 'apiClient' parameter is empty")
+	}
+
 	if nsname == "" {
 		glog.V(100).Infof("SriovNetworkNodeStates 'nsname' parameter can not be empty")
 
@@ -49,6 +55,12 @@ func ListPolicy(apiClient *clients.Settings, nsname string, options metaV1.ListO
 	glog.V(100).Infof("Listing SriovNetworkNodePolicies in the namespace %s with the options %v",
 		nsname, options)
 
+	if apiClient == nil {
+		glog.V(100).Infof("SriovNetworkNodePolicies 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list SriovNetworkNodePolicies, 'apiClient' parameter is empty")
+	}
+
 	if nsname == "" {
 		glog.V(100).Infof("SriovNetworkNodePolicies 'nsname' parameter can not be empty")
 
@@ -0,0 +1,15 @@
+package sriov
+
+import "github.com/openshift-kni/eco-goinfra/pkg/clients"
+
+// NamespaceComponent identifies the SR-IOV network operator in the namespace overrides of clients.Settings.
+const NamespaceComponent = "sriov"
+
+// DefaultNamespace is the namespace the SR-IOV network operator is installed in by default.
+var DefaultNamespace = "openshift-sriov-network-operator"
+
+// Namespace returns the namespace of the SR-IOV network operator, which is the namespace overridden on apiClient for
+// NamespaceComponent if any, or DefaultNamespace otherwise.
+func Namespace(apiClient *clients.Settings) string {
+	return apiClient.Namespace(NamespaceComponent, DefaultNamespace)
+}
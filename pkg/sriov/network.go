@@ -52,19 +52,19 @@ func NewNetworkBuilder(
 	}
 
 	if name == "" {
-		builder.errorMsg = "SrIovNetwork 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SrIovNetwork 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "SrIovNetwork 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SrIovNetwork 'nsname' cannot be empty")
 	}
 
 	if targetNsname == "" {
-		builder.errorMsg = "SrIovNetwork 'targetNsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SrIovNetwork 'targetNsname' cannot be empty")
 	}
 
 	if resName == "" {
-		builder.errorMsg = "SrIovNetwork 'resName' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SrIovNetwork 'resName' cannot be empty")
 	}
 
 	return &builder
@@ -77,7 +77,7 @@ func (builder *NetworkBuilder) WithVLAN(vlanID uint16) *NetworkBuilder {
 	}
 
 	if vlanID > 4094 {
-		builder.errorMsg = "invalid vlanID, allowed vlanID values are between 0-4094"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "invalid vlanID, allowed vlanID values are between 0-4094")
 	}
 
 	if builder.errorMsg != "" {
@@ -113,7 +113,7 @@ func (builder *NetworkBuilder) WithLinkState(linkState string) *NetworkBuilder {
 	allowedLinkStates := []string{"enable", "disable", "auto"}
 
 	if !slices.Contains(allowedLinkStates, linkState) {
-		builder.errorMsg = "invalid 'linkState' parameters"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "invalid 'linkState' parameters")
 	}
 
 	if builder.errorMsg != "" {
@@ -172,7 +172,8 @@ func (builder *NetworkBuilder) WithVlanQoS(qoSClass uint16) *NetworkBuilder {
 	}
 
 	if qoSClass > 7 {
-		builder.errorMsg = "Invalid QoS class. Supported vlan QoS class values are between 0...7"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "Invalid QoS class. Supported vlan QoS class values are between 0...7")
 	}
 
 	if builder.errorMsg != "" {
@@ -211,15 +212,19 @@ func (builder *NetworkBuilder) WithOptions(options ...NetworkAdditionalOptions)
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -243,13 +248,13 @@ func PullNetwork(apiClient *clients.Settings, name, nsname string) (*NetworkBuil
 	if name == "" {
 		glog.V(100).Infof("The name of the sriovnetwork is empty")
 
-		builder.errorMsg = "sriovnetwork 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "sriovnetwork 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the sriovnetwork is empty")
 
-		builder.errorMsg = "sriovnetwork 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "sriovnetwork 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -276,6 +281,8 @@ func (builder *NetworkBuilder) Create() (*NetworkBuilder, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
 	}
 
 	return builder, nil
@@ -298,6 +305,8 @@ func (builder *NetworkBuilder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Object)
+
 	builder.Object = nil
 
 	return err
@@ -320,6 +329,12 @@ func (builder *NetworkBuilder) Exists() bool {
 func List(apiClient *clients.Settings, nsname string, options metaV1.ListOptions) ([]*NetworkBuilder, error) {
 	glog.V(100).Infof("Listing sriov networks in the namespace %s with the options %v", nsname, options)
 
+	if apiClient == nil {
+		glog.V(100).Infof("sriov networks 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list sriov networks, 'apiClient' parameter is empty")
+	}
+
 	if nsname == "" {
 		glog.V(100).Infof("sriov network 'nsname' parameter can not be empty")
 
@@ -375,7 +390,7 @@ func (builder *NetworkBuilder) withIpam(ipamType string) *NetworkBuilder {
 	if ipamType == "" {
 		glog.V(100).Infof("sriov network 'ipamType' parameter can not be empty")
 
-		builder.errorMsg = "failed to configure IPAM, 'ipamType' parameter is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "failed to configure IPAM, 'ipamType' parameter is empty")
 	}
 
 	if builder.errorMsg != "" {
@@ -401,13 +416,14 @@ func (builder *NetworkBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -9,9 +9,9 @@ import (
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // NetworkNodeStateBuilder provides struct for SriovNetworkNodeState object which contains connection to cluster and
@@ -44,13 +44,13 @@ func NewNetworkNodeStateBuilder(apiClient *clients.Settings, nodeName, nsname st
 	if nodeName == "" {
 		glog.V(100).Infof("The name of the nodeName is empty")
 
-		builder.errorMsg = "SriovNetworkNodeState 'nodeName' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SriovNetworkNodeState 'nodeName' is empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the SriovNetworkNodeState is empty")
 
-		builder.errorMsg = "SriovNetworkNodeState 'nsname' is empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SriovNetworkNodeState 'nsname' is empty")
 	}
 
 	return builder
@@ -122,7 +122,8 @@ func (builder *NetworkNodeStateBuilder) GetNICs() (srIovV1.InterfaceExts, error)
 
 // WaitUntilSyncStatus waits for the duration of the defined timeout or until the
 // SriovNetworkNodeState gets to a specific syncStatus.
-func (builder *NetworkNodeStateBuilder) WaitUntilSyncStatus(syncStatus string, timeout time.Duration) error {
+func (builder *NetworkNodeStateBuilder) WaitUntilSyncStatus(
+	syncStatus string, timeout time.Duration, options ...await.WaitOption) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -137,14 +138,26 @@ func (builder *NetworkNodeStateBuilder) WaitUntilSyncStatus(syncStatus string, t
 	}
 
 	// Polls every retryInterval to determine if SriovNetworkNodeState is in desired syncStatus.
-	return wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	var lastObserved string
+
+	err := await.Poll(timeout, func() (bool, error) {
 		err := builder.Discover()
 
 		if err != nil {
 			return false, nil
 		}
 
+		lastObserved = fmt.Sprintf("syncStatus %q", builder.Objects.Status.SyncStatus)
+
 		return builder.Objects.Status.SyncStatus == syncStatus, nil
+	}, options...)
+
+	return await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          srIovV1.GroupVersion.WithKind("SriovNetworkNodeState"),
+		Name:         builder.nodeName,
+		Namespace:    builder.nsName,
+		Wanted:       fmt.Sprintf("syncStatus %q", syncStatus),
+		LastObserved: lastObserved,
 	})
 }
 
@@ -158,13 +171,13 @@ func (builder *NetworkNodeStateBuilder) GetNumVFs(sriovInterfaceName string) (in
 		sriovInterfaceName, builder.nodeName)
 
 	if builder.Objects == nil {
-		builder.errorMsg = msg.UndefinedCrdObjectErrString("SriovNetworkNodeState")
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString("SriovNetworkNodeState"))
 	}
 
 	if sriovInterfaceName == "" {
 		glog.V(100).Infof("The sriovInterface can not be empty string")
 
-		builder.errorMsg = "the sriovInterface is an empty sting"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "the sriovInterface is an empty sting")
 	}
 
 	if builder.errorMsg != "" {
@@ -194,7 +207,8 @@ func (builder *NetworkNodeStateBuilder) validate() (bool, error) {
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
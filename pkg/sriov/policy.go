@@ -60,23 +60,24 @@ func NewPolicyBuilder(
 	}
 
 	if name == "" {
-		builder.errorMsg = "SriovNetworkNodePolicy 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SriovNetworkNodePolicy 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "SriovNetworkNodePolicy 'nsname' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SriovNetworkNodePolicy 'nsname' cannot be empty")
 	}
 
 	if len(nicNames) == 0 {
-		builder.errorMsg = "SriovNetworkNodePolicy 'nicNames' cannot be empty list"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SriovNetworkNodePolicy 'nicNames' cannot be empty list")
 	}
 
 	if len(nodeSelector) == 0 {
-		builder.errorMsg = "SriovNetworkNodePolicy 'nodeSelector' cannot be empty map"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "SriovNetworkNodePolicy 'nodeSelector' cannot be empty map")
 	}
 
 	if vfsNumber <= 0 {
-		builder.errorMsg = "SriovNetworkNodePolicy 'vfsNumber' cannot be zero of negative"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "SriovNetworkNodePolicy 'vfsNumber' cannot be zero of negative")
 	}
 
 	return &builder
@@ -91,7 +92,8 @@ func (builder *PolicyBuilder) WithDevType(devType string) *PolicyBuilder {
 	allowedDevTypes := []string{"vfio-pci", "netdevice"}
 
 	if !slices.Contains(allowedDevTypes, devType) {
-		builder.errorMsg = "invalid device type, allowed devType values are: vfio-pci or netdevice"
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, "invalid device type, allowed devType values are: vfio-pci or netdevice")
 
 		return builder
 	}
@@ -108,11 +110,11 @@ func (builder *PolicyBuilder) WithVFRange(firstVF, lastVF int) *PolicyBuilder {
 	}
 
 	if firstVF > lastVF {
-		builder.errorMsg = "firstPF argument can not be greater than lastPF"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "firstPF argument can not be greater than lastPF")
 	}
 
 	if lastVF > 63 {
-		builder.errorMsg = "lastVF can not be greater than 63"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "lastVF can not be greater than 63")
 	}
 
 	if builder.errorMsg != "" {
@@ -136,7 +138,8 @@ func (builder *PolicyBuilder) WithMTU(mtu int) *PolicyBuilder {
 	}
 
 	if 1 > mtu || mtu > 9192 {
-		builder.errorMsg = fmt.Sprintf("invalid mtu size %d allowed mtu should be in range 1...9192", mtu)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("invalid mtu size %d allowed mtu should be in range 1...9192", mtu))
 	}
 
 	if builder.errorMsg != "" {
@@ -197,15 +200,19 @@ func (builder *PolicyBuilder) WithOptions(options ...PolicyAdditionalOptions) *P
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -229,13 +236,13 @@ func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilde
 	if name == "" {
 		glog.V(100).Infof("The name of the sriovnetworknodepolicy is empty")
 
-		builder.errorMsg = "sriovnetworknodepolicy 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "sriovnetworknodepolicy 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the sriovnetworknodepolicy is empty")
 
-		builder.errorMsg = "sriovnetworknodepolicy 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "sriovnetworknodepolicy 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -262,6 +269,8 @@ func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
 	}
 
 	return builder, nil
@@ -284,6 +293,8 @@ func (builder *PolicyBuilder) Delete() error {
 		return err
 	}
 
+	builder.apiClient.RunHooks(clients.HookEventDelete, builder.Definition)
+
 	builder.Object = nil
 
 	return err
@@ -316,13 +327,14 @@ func (builder *PolicyBuilder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
@@ -367,3 +379,42 @@ func CleanAllNetworkNodePolicies(apiClient *clients.Settings, operatornsname str
 
 	return nil
 }
+
+// DeleteAllOfNetworkNodePolicies removes all SriovNetworkNodePolicies matching options, except the mandatory
+// "default" one, with a single DeleteCollection call.
+func DeleteAllOfNetworkNodePolicies(
+	apiClient *clients.Settings, operatornsname string, options metaV1.ListOptions) error {
+	glog.V(100).Infof("Deleting all SriovNetworkNodePolicies in the %s namespace with the options %v",
+		operatornsname, options)
+
+	if apiClient == nil {
+		glog.V(100).Infof("SriovNetworkNodePolicies 'apiClient' parameter can not be empty")
+
+		return fmt.Errorf("failed to delete SriovNetworkNodePolicies, 'apiClient' parameter is empty")
+	}
+
+	if operatornsname == "" {
+		glog.V(100).Infof("'operatornsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to delete SriovNetworkNodePolicies, 'operatornsname' parameter is empty")
+	}
+
+	// The "default" SriovNetworkNodePolicy is both mandatory and the default option.
+	defaultPolicySelector := "metadata.name!=default"
+	if options.FieldSelector == "" {
+		options.FieldSelector = defaultPolicySelector
+	} else {
+		options.FieldSelector = options.FieldSelector + "," + defaultPolicySelector
+	}
+
+	err := apiClient.SriovNetworkNodePolicies(operatornsname).DeleteCollection(
+		context.TODO(), metaV1.DeleteOptions{}, options)
+	if err != nil {
+		glog.V(100).Infof("Failed to delete SriovNetworkNodePolicies in namespace %s due to %s",
+			operatornsname, err.Error())
+
+		return err
+	}
+
+	return nil
+}
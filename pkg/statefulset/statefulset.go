@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "k8s.io/api/apps/v1"
@@ -13,7 +14,6 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // Builder provides struct for statefulset object containing connection to the cluster and the statefulset definitions.
@@ -68,19 +68,19 @@ func NewBuilder(
 	if name == "" {
 		glog.V(100).Infof("The name of the statefulset is empty")
 
-		builder.errorMsg = "statefulset 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "statefulset 'name' cannot be empty")
 	}
 
 	if nsname == "" {
 		glog.V(100).Infof("The namespace of the statefulset is empty")
 
-		builder.errorMsg = "statefulset 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "statefulset 'namespace' cannot be empty")
 	}
 
 	if labels == nil {
 		glog.V(100).Infof("There are no labels for the statefulset")
 
-		builder.errorMsg = "statefulset 'labels' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "statefulset 'labels' cannot be empty")
 	}
 
 	return &builder
@@ -98,7 +98,7 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []coreV1.Container) *
 	if specs == nil {
 		glog.V(100).Infof("The container specs are empty")
 
-		builder.errorMsg = "cannot accept nil or empty list as container specs"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "cannot accept nil or empty list as container specs")
 	}
 
 	if builder.errorMsg != "" {
@@ -126,15 +126,19 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 	for _, option := range options {
 		if option != nil {
-			builder, err := option(builder)
+			mutatedBuilder, err := option(builder)
 
 			if err != nil {
 				glog.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, err.Error())
 
 				return builder
 			}
+
+			if mutatedBuilder != nil {
+				builder = mutatedBuilder
+			}
 		}
 	}
 
@@ -156,11 +160,11 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		builder.errorMsg = "statefulset 'name' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "statefulset 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "statefulset 'namespace' cannot be empty"
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, "statefulset 'namespace' cannot be empty")
 	}
 
 	if !builder.Exists() {
@@ -184,6 +188,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.StatefulSets(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metaV1.CreateOptions{})
+		if err == nil {
+			builder.apiClient.RunHooks(clients.HookEventCreate, builder.Object)
+		}
 	}
 
 	return builder, err
@@ -206,7 +213,7 @@ func (builder *Builder) Exists() bool {
 }
 
 // IsReady periodically checks if statefulset is in ready status.
-func (builder *Builder) IsReady(timeout time.Duration) bool {
+func (builder *Builder) IsReady(timeout time.Duration, options ...await.WaitOption) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -218,7 +225,7 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 		return false
 	}
 
-	err := wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+	err := await.Poll(timeout, func() (bool, error) {
 
 		var err error
 		builder.Object, err = builder.apiClient.StatefulSets(builder.Definition.Namespace).Get(
@@ -233,7 +240,7 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 		}
 
 		return false, nil
-	})
+	}, options...)
 
 	return err == nil
 }
@@ -247,6 +254,12 @@ func GetGVR() schema.GroupVersionResource {
 func List(apiClient *clients.Settings, nsname string, options metaV1.ListOptions) ([]*Builder, error) {
 	glog.V(100).Infof("Listing statefulsets in the namespace %s with the options %v", nsname, options)
 
+	if apiClient == nil {
+		glog.V(100).Infof("statefulsets 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list statefulsets, 'apiClient' parameter is empty")
+	}
+
 	if nsname == "" {
 		glog.V(100).Infof("statefulset 'nsname' parameter can not be empty")
 
@@ -291,13 +304,14 @@ func (builder *Builder) validate() (bool, error) {
 	if builder.Definition == nil {
 		glog.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(builder.errorMsg, msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
 		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		builder.errorMsg = msg.AppendErrorMsg(
+			builder.errorMsg, fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
 	if builder.errorMsg != "" {
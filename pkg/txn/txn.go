@@ -0,0 +1,132 @@
+package txn
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/glog"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// UndoFunc reverts a single mutation recorded in a Transaction.
+type UndoFunc func() error
+
+// step is a mutation recorded in a Transaction together with the function reverting it.
+type step struct {
+	description string
+	undo        UndoFunc
+}
+
+// Transaction records a sequence of mutations, such as builder updates, patches or label changes, with the functions
+// reverting them, so that they can be rolled back as a unit, e.g. in a test AfterEach block.
+type Transaction struct {
+	name  string
+	steps []step
+	mutex sync.Mutex
+}
+
+// New creates a new empty Transaction. The name is only used for logging.
+func New(name string) *Transaction {
+	glog.V(100).Infof("Initializing new transaction %s", name)
+
+	return &Transaction{name: name}
+}
+
+// Record adds a mutation that was already applied to the transaction. The undo function is called on Rollback.
+func (transaction *Transaction) Record(description string, undo UndoFunc) error {
+	if transaction == nil {
+		return fmt.Errorf("error: received nil transaction")
+	}
+
+	if undo == nil {
+		glog.V(100).Infof("The undo function of step %s is nil", description)
+
+		return fmt.Errorf("failed to record step %s, 'undo' cannot be nil", description)
+	}
+
+	glog.V(100).Infof("Recording step %s in transaction %s", description, transaction.name)
+
+	transaction.mutex.Lock()
+	defer transaction.mutex.Unlock()
+
+	transaction.steps = append(transaction.steps, step{description: description, undo: undo})
+
+	return nil
+}
+
+// Do applies the mutation and records its undo function if the mutation succeeded. A failed mutation is not recorded,
+// it is expected to leave the cluster unchanged.
+func (transaction *Transaction) Do(description string, mutate func() error, undo UndoFunc) error {
+	if transaction == nil {
+		return fmt.Errorf("error: received nil transaction")
+	}
+
+	if mutate == nil || undo == nil {
+		glog.V(100).Infof("The mutate or undo function of step %s is nil", description)
+
+		return fmt.Errorf("failed to apply step %s, 'mutate' and 'undo' cannot be nil", description)
+	}
+
+	glog.V(100).Infof("Applying step %s in transaction %s", description, transaction.name)
+
+	if err := mutate(); err != nil {
+		return fmt.Errorf("failed to apply step %s: %w", description, err)
+	}
+
+	return transaction.Record(description, undo)
+}
+
+// Len returns the number of steps recorded in the transaction.
+func (transaction *Transaction) Len() int {
+	if transaction == nil {
+		return 0
+	}
+
+	transaction.mutex.Lock()
+	defer transaction.mutex.Unlock()
+
+	return len(transaction.steps)
+}
+
+// Rollback undoes all recorded steps in reverse order. Every step is attempted even if an earlier one fails, and all
+// failures are returned as a single aggregated error. The transaction is empty afterwards.
+func (transaction *Transaction) Rollback() error {
+	if transaction == nil {
+		return fmt.Errorf("error: received nil transaction")
+	}
+
+	transaction.mutex.Lock()
+	steps := transaction.steps
+	transaction.steps = nil
+	transaction.mutex.Unlock()
+
+	glog.V(100).Infof("Rolling back %d steps of transaction %s", len(steps), transaction.name)
+
+	var errs []error
+
+	for index := len(steps) - 1; index >= 0; index-- {
+		glog.V(100).Infof("Undoing step %s", steps[index].description)
+
+		if err := steps[index].undo(); err != nil {
+			glog.V(100).Infof("Failed to undo step %s due to %s", steps[index].description, err.Error())
+
+			errs = append(errs, fmt.Errorf("failed to undo step %s: %w", steps[index].description, err))
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Commit discards all recorded steps, so that a later Rollback does not revert them.
+func (transaction *Transaction) Commit() {
+	if transaction == nil {
+		return
+	}
+
+	glog.V(100).Infof("Committing transaction %s", transaction.name)
+
+	transaction.mutex.Lock()
+	defer transaction.mutex.Unlock()
+
+	transaction.steps = nil
+}
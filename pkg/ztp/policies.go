@@ -0,0 +1,190 @@
+package ztp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/await"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// CompliantState is the compliance state reported by a policy enforced on the cluster.
+	CompliantState = "Compliant"
+	// NonCompliantState is the compliance state reported by a policy violated on the cluster.
+	NonCompliantState = "NonCompliant"
+)
+
+// policyGVR is the resource of the open-cluster-management policies. The policies replicated to a managed cluster
+// live in the namespace named after the cluster on the hub.
+var policyGVR = schema.GroupVersionResource{
+	Group:    "policy.open-cluster-management.io",
+	Version:  "v1",
+	Resource: "policies",
+}
+
+// PolicyStatus contains the compliance of a single policy targeting a cluster.
+type PolicyStatus struct {
+	// Name is the name of the replicated policy, in the <root policy namespace>.<root policy name> form.
+	Name string
+	// ComplianceState is empty until the policy was evaluated on the cluster.
+	ComplianceState string
+	// Violations contains the latest message of every non compliant policy template.
+	Violations []string
+}
+
+// GetPoliciesForCluster returns the compliance of all hub policies replicated to the given managed cluster.
+func GetPoliciesForCluster(apiClient *clients.Settings, clusterName string) ([]PolicyStatus, error) {
+	glog.V(100).Infof("Getting policies targeting cluster %s", clusterName)
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to get policies, 'apiClient' parameter is nil")
+	}
+
+	if clusterName == "" {
+		glog.V(100).Infof("The cluster name is empty")
+
+		return nil, fmt.Errorf("failed to get policies, 'clusterName' parameter is empty")
+	}
+
+	policyList, err := apiClient.Resource(policyGVR).Namespace(clusterName).List(
+		context.TODO(), metaV1.ListOptions{})
+	if err != nil {
+		glog.V(100).Infof("Failed to list policies for cluster %s due to %s", clusterName, err.Error())
+
+		return nil, err
+	}
+
+	var policies []PolicyStatus
+
+	for _, policy := range policyList.Items {
+		policies = append(policies, getPolicyStatus(policy))
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	return policies, nil
+}
+
+// WaitForPoliciesCompliantForCluster waits until all hub policies targeting the given managed cluster are
+// Compliant. At least one policy must target the cluster. On timeout a *await.WaitTimeoutError is returned whose
+// LastObserved lists the non compliant policies together with their violation messages.
+func WaitForPoliciesCompliantForCluster(
+	apiClient *clients.Settings, clusterName string, timeout time.Duration, options ...await.WaitOption) error {
+	glog.V(100).Infof("Waiting for all policies targeting cluster %s to be compliant", clusterName)
+
+	var nonCompliant []PolicyStatus
+
+	err := await.Poll(timeout, func() (bool, error) {
+		policies, err := GetPoliciesForCluster(apiClient, clusterName)
+		if err != nil {
+			if apiClient == nil || clusterName == "" {
+				return false, err
+			}
+
+			return false, nil
+		}
+
+		nonCompliant = nil
+
+		for _, policy := range policies {
+			if policy.ComplianceState != CompliantState {
+				nonCompliant = append(nonCompliant, policy)
+			}
+		}
+
+		return len(policies) > 0 && len(nonCompliant) == 0, nil
+	}, options...)
+
+	if err == nil {
+		return nil
+	}
+
+	lastObserved := "no policies targeting the cluster"
+	if len(nonCompliant) > 0 {
+		lastObserved = strings.Join(getNonCompliantReasons(nonCompliant), ", ")
+	}
+
+	err = await.WithTimeoutDetails(err, await.WaitTimeoutError{
+		GVK:          policyGVR.GroupVersion().WithKind("Policy"),
+		Namespace:    clusterName,
+		Wanted:       fmt.Sprintf("compliance state %s", CompliantState),
+		LastObserved: lastObserved,
+	})
+
+	var timeoutErr *await.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		return err
+	}
+
+	return fmt.Errorf("policies targeting cluster %s are not compliant: %w", clusterName, err)
+}
+
+// getNonCompliantReasons describes the compliance state and the violations of every given policy.
+func getNonCompliantReasons(nonCompliant []PolicyStatus) []string {
+	var reasons []string
+
+	for _, policy := range nonCompliant {
+		state := policy.ComplianceState
+		if state == "" {
+			state = "not evaluated"
+		}
+
+		reason := fmt.Sprintf("%s (%s)", policy.Name, state)
+		if len(policy.Violations) > 0 {
+			reason = fmt.Sprintf("%s: %s", reason, strings.Join(policy.Violations, "; "))
+		}
+
+		reasons = append(reasons, reason)
+	}
+
+	return reasons
+}
+
+// getPolicyStatus extracts the compliance state and violation messages from an unstructured policy.
+func getPolicyStatus(policy unstructured.Unstructured) PolicyStatus {
+	status := PolicyStatus{Name: policy.GetName()}
+	status.ComplianceState, _, _ = unstructured.NestedString(policy.Object, "status", "compliant")
+
+	details, _, _ := unstructured.NestedSlice(policy.Object, "status", "details")
+
+	for _, detail := range details {
+		detailMap, ok := detail.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		compliant, _, _ := unstructured.NestedString(detailMap, "compliant")
+		if compliant == CompliantState {
+			continue
+		}
+
+		history, _, _ := unstructured.NestedSlice(detailMap, "history")
+		if len(history) == 0 {
+			continue
+		}
+
+		latest, ok := history[0].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if message, _, _ := unstructured.NestedString(latest, "message"); message != "" {
+			status.Violations = append(status.Violations, message)
+		}
+	}
+
+	return status
+}